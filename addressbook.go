@@ -0,0 +1,195 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"belajar-golang/internal/addressbook"
+)
+
+func init() {
+	registerCommand(command{
+		Name:  "addressbook",
+		Usage: "addressbook -file FILE list|add|delete|search|dupes|import|export ...",
+		Run:   addressBookCommand,
+	})
+}
+
+func printEntries(entries []addressbook.Entry) {
+	if len(entries) == 0 {
+		logln("  (kosong)")
+	}
+	for _, e := range entries {
+		logf("  #%d %s %s (%d) <%s> %s, %s %s\n", e.ID, e.Person.FirstName, e.Person.LastName,
+			e.Person.Age, e.Contact.Email, e.Contact.Phone, e.Contact.HomeAddress.City, e.Contact.HomeAddress.ZipCode)
+	}
+}
+
+func addressBookCommand(args []string) error {
+	fs := flag.NewFlagSet("addressbook", flag.ContinueOnError)
+	path := fs.String("file", "addressbook.jsonl", "file JSON-lines buku alamat")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errUsage
+	}
+	ab, err := addressbook.OpenAddressBook(*path)
+	if err != nil {
+		return err
+	}
+
+	rest := fs.Args()[1:]
+	switch fs.Arg(0) {
+	case "list":
+		printEntries(ab.List())
+	case "add":
+		// add FIRST LAST AGE EMAIL PHONE [STREET CITY ZIP]
+		if len(rest) != 5 && len(rest) != 8 {
+			return fmt.Errorf("%w: add FIRST LAST AGE EMAIL PHONE [STREET CITY ZIP]", errUsage)
+		}
+		age, err := strconv.Atoi(rest[2])
+		if err != nil {
+			return fmt.Errorf("umur tidak valid: %w", err)
+		}
+		e := addressbook.Entry{
+			Person:  addressbook.Person{FirstName: rest[0], LastName: rest[1], Age: age},
+			Contact: addressbook.Contact{Email: rest[3], Phone: rest[4]},
+		}
+		if len(rest) == 8 {
+			e.Contact.HomeAddress = addressbook.Address{Street: rest[5], City: rest[6], ZipCode: rest[7]}
+		}
+		e, err = ab.Add(e)
+		if err != nil {
+			return err
+		}
+		logf("Kontak #%d ditambahkan.\n", e.ID)
+	case "delete":
+		if len(rest) != 1 {
+			return fmt.Errorf("%w: delete ID", errUsage)
+		}
+		id, err := strconv.Atoi(rest[0])
+		if err != nil {
+			return fmt.Errorf("ID tidak valid: %w", err)
+		}
+		return ab.Delete(id)
+	case "search":
+		if len(rest) != 2 {
+			return fmt.Errorf("%w: search name|city|zip QUERY", errUsage)
+		}
+		switch rest[0] {
+		case "name":
+			printEntries(ab.SearchByName(rest[1]))
+		case "city":
+			printEntries(ab.SearchByCity(rest[1]))
+		case "zip":
+			printEntries(ab.SearchByZip(rest[1]))
+		default:
+			return fmt.Errorf("%w: field pencarian tidak dikenal %q", errUsage, rest[0])
+		}
+	case "dupes":
+		for _, group := range ab.FindDuplicates() {
+			logln("Kemungkinan duplikat:")
+			printEntries(group)
+		}
+	case "import":
+		if len(rest) != 1 {
+			return fmt.Errorf("%w: import FILE.csv|FILE.vcf", errUsage)
+		}
+		f, err := os.Open(rest[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		var report addressbook.ImportReport
+		if strings.EqualFold(filepath.Ext(rest[0]), ".vcf") {
+			report, err = ab.ImportVCard(f)
+		} else {
+			report, err = ab.ImportCSV(f)
+		}
+		logf("%d kontak diimpor, %d duplikat dilewati.\n", report.Added, len(report.Duplicates))
+		for _, dup := range report.Duplicates {
+			logln("  -", dup)
+		}
+		return err
+	case "export":
+		if len(rest) != 1 {
+			return fmt.Errorf("%w: export csv|vcard", errUsage)
+		}
+		switch rest[0] {
+		case "csv":
+			return ab.ExportCSV(os.Stdout)
+		case "vcard":
+			return ab.ExportVCard(os.Stdout)
+		default:
+			return fmt.Errorf("%w: format ekspor tidak dikenal %q", errUsage, rest[0])
+		}
+	default:
+		return errUsage
+	}
+	return nil
+}
+
+func addressBookExample() {
+	dir, err := os.MkdirTemp("", "addressbook")
+	if err != nil {
+		logln("Gagal membuat direktori sementara:", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "kontak.jsonl")
+	ab, err := addressbook.OpenAddressBook(path)
+	if err != nil {
+		logln("Gagal membuka buku alamat:", err)
+		return
+	}
+
+	andi, _ := ab.Add(addressbook.Entry{
+		Person: addressbook.Person{FirstName: "Andi", LastName: "Wijaya", Age: 28},
+		Contact: addressbook.Contact{
+			Email:       "andi.w@example.com",
+			Phone:       "0812345678",
+			HomeAddress: addressbook.Address{Street: "Jl. Merdeka No. 10", City: "Jakarta", ZipCode: "10110"},
+		},
+	})
+	ab.Add(addressbook.Entry{
+		Person:  addressbook.Person{FirstName: "Siti", LastName: "Aminah", Age: 32},
+		Married: true,
+		Contact: addressbook.Contact{
+			Email:       "siti@example.com",
+			Phone:       "0813-0000-1111",
+			HomeAddress: addressbook.Address{Street: "Jl. Asia Afrika 8", City: "Bandung", ZipCode: "40111"},
+		},
+	})
+
+	_, err = ab.Add(addressbook.Entry{
+		Person:  addressbook.Person{FirstName: "Andi", LastName: "W."},
+		Contact: addressbook.Contact{Phone: "+62 812-345-678"},
+	})
+	logln("Tambah kontak dengan nomor yang sama:", err)
+
+	logln("Cari kota 'jakarta':")
+	printEntries(ab.SearchByCity("jakarta"))
+
+	andi.Contact.HomeAddress.City = "Surabaya"
+	if err := ab.Update(andi.ID, andi); err != nil {
+		logln("Gagal update:", err)
+	}
+
+	reopened, err := addressbook.OpenAddressBook(path)
+	if err != nil {
+		logln("Gagal membuka ulang:", err)
+		return
+	}
+	logln("Setelah dibuka ulang dari file:")
+	printEntries(reopened.List())
+
+	logln("Ekspor vCard:")
+	logOutput(func(w io.Writer) { reopened.ExportVCard(w) })
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// The allocation report puts two views of the same question side by
+// side: what the compiler decided to put on the heap (-gcflags=-m=2) and
+// how many allocations a lesson really makes when it runs
+// (testing.AllocsPerRun). Escapes are collected for the lesson function
+// and for the functions it calls directly, because helpers such as
+// createMultiplier or counter are where the closures are built.
+
+type LessonAllocs struct {
+	Lesson Lesson
+	Allocs float64
+	// Funcs is the lesson function followed by its direct callees;
+	// Escapes holds the heap escapes of each of them.
+	Funcs   []string
+	Escapes map[string][]EscapeDiagnostic
+}
+
+func init() {
+	registerCommand(command{
+		Name:  "allocs",
+		Usage: "allocs [-src DIR] [-runs N] [-all] [-why] [PELAJARAN...]",
+		Run:   allocsCommand,
+	})
+}
+
+func (r LessonAllocs) EscapeCount() int {
+	n := 0
+	for _, list := range r.Escapes {
+		n += len(list)
+	}
+	return n
+}
+
+// BuildAllocReport compiles dir with -m=2 and runs every lesson in list.
+// dir must hold the source of this very program, otherwise the
+// diagnostics describe different code from the one being measured.
+func BuildAllocReport(dir string, list []Lesson, runs int) ([]LessonAllocs, error) {
+	diags, err := RunEscapeAnalysis(dir, 2)
+	if err != nil {
+		return nil, err
+	}
+	if err := AttachFuncs(diags); err != nil {
+		return nil, err
+	}
+	graph, err := CallGraph(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]LessonAllocs, len(list))
+	for i, l := range list {
+		fn := l.FuncName()
+		funcs := append([]string{fn}, graph[fn]...)
+		escapes := map[string][]EscapeDiagnostic{}
+		for name, ds := range EscapesByFunc(diags, funcs...) {
+			for _, d := range ds {
+				if d.Escapes() {
+					escapes[name] = append(escapes[name], d)
+				}
+			}
+		}
+		report[i] = LessonAllocs{Lesson: l, Funcs: funcs, Escapes: escapes}
+	}
+
+	allocs, err := measureAllocs(list, runs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range report {
+		report[i].Allocs = allocs[i]
+	}
+	return report, nil
+}
+
+// measureAllocs runs each lesson under testing.AllocsPerRun with os.Stdout
+// pointed at /dev/null, so the lessons' own output stays out of the report.
+func measureAllocs(list []Lesson, runs int) ([]float64, error) {
+	allocs := make([]float64, len(list))
+	err := discardStdout(func() {
+		for i, l := range list {
+			allocs[i] = testing.AllocsPerRun(runs, l.Run)
+		}
+	})
+	return allocs, err
+}
+
+func writeAllocReport(w io.Writer, report []LessonAllocs, all, why bool) {
+	for _, r := range report {
+		fmt.Fprintf(w, "=== %s [%s] ===\n", r.Lesson.Title, r.Lesson.ID)
+		fmt.Fprintf(w, "Alokasi per run: %.0f, escape ke heap: %d\n", r.Allocs, r.EscapeCount())
+		hidden := 0
+		for _, fn := range r.Funcs {
+			var shown []EscapeDiagnostic
+			for _, d := range r.Escapes[fn] {
+				if d.Kind() == "interface" && !all {
+					hidden++
+					continue
+				}
+				shown = append(shown, d)
+			}
+			if len(shown) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "  %s\n", fn)
+			for _, d := range shown {
+				fmt.Fprintf(w, "    %-9s %s\n", d.Kind(), d)
+				if !why {
+					continue
+				}
+				for _, step := range d.Why {
+					fmt.Fprintf(w, "              %s\n", strings.ReplaceAll(step, "./", ""))
+				}
+			}
+		}
+		if hidden > 0 {
+			fmt.Fprintf(w, "  (+%d nilai dikonversi ke interface, kebanyakan argumen fmt; -all untuk menampilkan)\n", hidden)
+		}
+		fmt.Fprintln(w)
+	}
+
+	sorted := append([]LessonAllocs(nil), report...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Allocs > sorted[j].Allocs
+	})
+	fmt.Fprintln(w, "Ringkasan (urut dari alokasi terbanyak):")
+	fmt.Fprintf(w, "  %-18s %12s %8s\n", "pelajaran", "alokasi/run", "escape")
+	for _, r := range sorted {
+		fmt.Fprintf(w, "  %-18s %12.0f %8d\n", r.Lesson.ID, r.Allocs, r.EscapeCount())
+	}
+}
+
+func allocsCommand(args []string) error {
+	fs := flag.NewFlagSet("allocs", flag.ContinueOnError)
+	src := fs.String("src", ".", "direktori sumber program pelajaran")
+	runs := fs.Int("runs", 5, "jumlah run per pelajaran untuk testing.AllocsPerRun")
+	all := fs.Bool("all", false, "tampilkan juga nilai yang escape karena dikonversi ke interface")
+	why := fs.Bool("why", false, "tampilkan alur data penyebab escape (-m=2)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *runs < 1 {
+		return fmt.Errorf("%w: -runs harus minimal 1", errUsage)
+	}
+	list, err := selectLessons(fs.Args())
+	if err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+
+	report, err := BuildAllocReport(*src, list, *runs)
+	if err != nil {
+		return err
+	}
+	writeAllocReport(os.Stdout, report, *all, *why)
+	return nil
+}
@@ -0,0 +1,696 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"belajar-golang/internal/table"
+)
+
+// exampleBenchmarkMyFunction shows the shape of a benchmark, but a single
+// run of a single benchmark says little: timings wobble from run to run,
+// and "faster" only means something next to a variant measured the same
+// way. This file runs named sets of variants several times each, reports
+// mean, standard deviation and a 95% confidence interval, and compares a
+// run against a saved baseline with the Mann-Whitney U test, the test
+// benchstat uses by default.
+//
+// Baselines are stored in the text format of `go test -bench`, so a file
+// written by -save can also be fed to benchstat itself.
+
+type BenchmarkCase struct {
+	Name string
+	Fn   func(b *testing.B)
+}
+
+type BenchmarkSet struct {
+	Name  string
+	Title string
+	Cases []BenchmarkCase
+}
+
+type BenchSample struct {
+	N           int
+	NsPerOp     float64
+	BytesPerOp  int64
+	AllocsPerOp int64
+}
+
+// BenchResult holds every sample of one benchmark. Name is "set/case".
+type BenchResult struct {
+	Name    string
+	Samples []BenchSample
+}
+
+type BenchSummary struct {
+	N      int
+	Mean   float64
+	StdDev float64
+	// CI95 is the half-width of the 95% confidence interval of the mean.
+	CI95        float64
+	BytesPerOp  int64
+	AllocsPerOp int64
+}
+
+// BenchComparison is one benchmark measured in two runs. P is the
+// two-sided p-value of the Mann-Whitney U test; the change counts only
+// when P is below benchAlpha.
+type BenchComparison struct {
+	Name     string
+	Old, New BenchSummary
+	Delta    float64 // relative change of the mean, -0.1 is 10% faster
+	P        float64
+}
+
+const benchAlpha = 0.05
+
+// Sinks keep the compiler from optimising the benchmarked work away.
+var (
+	benchSinkString string
+	benchSinkInt    int
+)
+
+func init() {
+	registerCommand(command{
+		Name:  "bench",
+		Usage: "bench [-count N] [-benchtime D] [-baseline FILE] [-save FILE] [-format text|markdown] [-list] [SET...]",
+		Run:   benchCommand,
+	})
+}
+
+var benchmarkSets = []BenchmarkSet{
+	{"concat", "Penggabungan string", concatBenchmarks()},
+	{"lookup", "Pencarian kunci: map vs slice", lookupBenchmarks()},
+	{"counter", "Penghitung bersama: mutex vs atomic", counterBenchmarks()},
+	{"contoh", "exampleBenchmarkMyFunction dari bagian Testing", []BenchmarkCase{
+		{"myfunction", exampleBenchmarkMyFunction},
+	}},
+}
+
+func concatBenchmarks() []BenchmarkCase {
+	words := strings.Fields(strings.Repeat("Go itu sederhana cepat dan menyenangkan untuk dipelajari ", 8))
+	return []BenchmarkCase{
+		{"plus", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				s := ""
+				for _, w := range words {
+					s += w + " "
+				}
+				benchSinkString = s
+			}
+		}},
+		{"builder", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var sb strings.Builder
+				for _, w := range words {
+					sb.WriteString(w)
+					sb.WriteByte(' ')
+				}
+				benchSinkString = sb.String()
+			}
+		}},
+		{"builder-grow", func(b *testing.B) {
+			size := 0
+			for _, w := range words {
+				size += len(w) + 1
+			}
+			for i := 0; i < b.N; i++ {
+				var sb strings.Builder
+				sb.Grow(size)
+				for _, w := range words {
+					sb.WriteString(w)
+					sb.WriteByte(' ')
+				}
+				benchSinkString = sb.String()
+			}
+		}},
+		{"join", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchSinkString = strings.Join(words, " ")
+			}
+		}},
+	}
+}
+
+// lookupBenchmarks search the city names of the sample table, a size at
+// which a linear scan is still competitive.
+func lookupBenchmarks() []BenchmarkCase {
+	cities := SampleCities()
+	keys := make([]string, len(cities.Rows))
+	index := map[string]int{}
+	for i, row := range cities.Rows {
+		keys[i] = row[0].(string)
+		index[keys[i]] = i
+	}
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+
+	return []BenchmarkCase{
+		{"map", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchSinkInt = index[keys[i%len(keys)]]
+			}
+		}},
+		{"slice-linear", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchSinkInt = slices.Index(keys, keys[i%len(keys)])
+			}
+		}},
+		{"slice-biner", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchSinkInt, _ = slices.BinarySearch(sorted, keys[i%len(keys)])
+			}
+		}},
+	}
+}
+
+func counterBenchmarks() []BenchmarkCase {
+	return []BenchmarkCase{
+		{"mutex", func(b *testing.B) {
+			var mu sync.Mutex
+			n := 0
+			for i := 0; i < b.N; i++ {
+				mu.Lock()
+				n++
+				mu.Unlock()
+			}
+			benchSinkInt = n
+		}},
+		{"atomic", func(b *testing.B) {
+			var n atomic.Int64
+			for i := 0; i < b.N; i++ {
+				n.Add(1)
+			}
+			benchSinkInt = int(n.Load())
+		}},
+		{"mutex-paralel", func(b *testing.B) {
+			var mu sync.Mutex
+			n := 0
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					mu.Lock()
+					n++
+					mu.Unlock()
+				}
+			})
+			benchSinkInt = n
+		}},
+		{"atomic-paralel", func(b *testing.B) {
+			var n atomic.Int64
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					n.Add(1)
+				}
+			})
+			benchSinkInt = int(n.Load())
+		}},
+	}
+}
+
+func findBenchmarkSet(name string) (BenchmarkSet, bool) {
+	for _, s := range benchmarkSets {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return BenchmarkSet{}, false
+}
+
+// RunBenchmarkSet runs every case count times. The runs are interleaved,
+// case by case, so that a slow moment of the machine is spread over all
+// variants instead of landing on one of them.
+func RunBenchmarkSet(set BenchmarkSet, count int, progress io.Writer) []BenchResult {
+	results := make([]BenchResult, len(set.Cases))
+	for i, c := range set.Cases {
+		results[i].Name = set.Name + "/" + c.Name
+	}
+	for range count {
+		for i, c := range set.Cases {
+			r := testing.Benchmark(c.Fn)
+			results[i].Samples = append(results[i].Samples, BenchSample{
+				N:           r.N,
+				NsPerOp:     float64(r.T.Nanoseconds()) / float64(max(r.N, 1)),
+				BytesPerOp:  r.AllocedBytesPerOp(),
+				AllocsPerOp: r.AllocsPerOp(),
+			})
+			fmt.Fprint(progress, ".")
+		}
+	}
+	fmt.Fprintln(progress)
+	return results
+}
+
+func (r BenchResult) Summary() BenchSummary {
+	ns := make([]float64, len(r.Samples))
+	for i, s := range r.Samples {
+		ns[i] = s.NsPerOp
+	}
+	sum := BenchSummary{N: len(ns), Mean: mean(ns), StdDev: stdDev(ns)}
+	if sum.N > 1 {
+		sum.CI95 = tCritical95(sum.N-1) * sum.StdDev / math.Sqrt(float64(sum.N))
+	}
+	if sum.N > 0 {
+		last := r.Samples[sum.N-1]
+		sum.BytesPerOp, sum.AllocsPerOp = last.BytesPerOp, last.AllocsPerOp
+	}
+	return sum
+}
+
+func (r BenchResult) nsPerOp() []float64 {
+	ns := make([]float64, len(r.Samples))
+	for i, s := range r.Samples {
+		ns[i] = s.NsPerOp
+	}
+	return ns
+}
+
+func mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// stdDev is the sample standard deviation (divided by n-1).
+func stdDev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	m := mean(xs)
+	sum := 0.0
+	for _, x := range xs {
+		sum += (x - m) * (x - m)
+	}
+	return math.Sqrt(sum / float64(len(xs)-1))
+}
+
+// tTable95 holds the two-sided 95% critical values of Student's t
+// distribution for 1 to 30 degrees of freedom.
+var tTable95 = [...]float64{
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+func tCritical95(df int) float64 {
+	if df >= 1 && df <= len(tTable95) {
+		return tTable95[df-1]
+	}
+	return 1.96 // the normal distribution is close enough past 30
+}
+
+// MannWhitneyU returns the two-sided p-value for the hypothesis that x
+// and y come from the same distribution. Small samples without ties use
+// the exact distribution of U; otherwise the normal approximation with a
+// tie correction is used.
+func MannWhitneyU(x, y []float64) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+	type obs struct {
+		v     float64
+		fromX bool
+	}
+	all := make([]obs, 0, n1+n2)
+	for _, v := range x {
+		all = append(all, obs{v, true})
+	}
+	for _, v := range y {
+		all = append(all, obs{v, false})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].v < all[j].v
+	})
+
+	// Ranks start at 1; tied values share the average of their ranks.
+	rankSumX, tieTerm := 0.0, 0.0
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].v == all[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if all[k].fromX {
+				rankSumX += rank
+			}
+		}
+		t := float64(j - i)
+		tieTerm += t*t*t - t
+		i = j
+	}
+
+	u1 := rankSumX - float64(n1*(n1+1))/2
+	u := math.Min(u1, float64(n1*n2)-u1)
+	if tieTerm == 0 && n1+n2 <= 50 {
+		return math.Min(1, 2*exactUCDF(n1, n2, int(u)))
+	}
+
+	n := float64(n1 + n2)
+	mu := float64(n1*n2) / 2
+	sigma := math.Sqrt(float64(n1*n2) / 12 * ((n + 1) - tieTerm/(n*(n-1))))
+	if sigma == 0 {
+		return 1
+	}
+	z := (u - mu + 0.5) / sigma
+	return math.Min(1, math.Erfc(-z/math.Sqrt2))
+}
+
+// exactUCDF is P(U <= u) for samples of size n1 and n2 when both come
+// from the same distribution: the share of the C(n1+n2, n1) orderings of
+// the pooled sample whose U statistic is at most u. counts[j][k] is the
+// number of orderings of i x-values and j y-values with U = k, built up
+// one i at a time.
+func exactUCDF(n1, n2, u int) float64 {
+	maxU := n1 * n2
+	counts := make([][]float64, n2+1)
+	for j := range counts {
+		counts[j] = make([]float64, maxU+1)
+		counts[j][0] = 1 // i = 0: only one ordering, with U = 0
+	}
+	for i := 1; i <= n1; i++ {
+		next := make([][]float64, n2+1)
+		for j := range next {
+			next[j] = make([]float64, maxU+1)
+		}
+		next[0][0] = 1
+		for j := 1; j <= n2; j++ {
+			for k := 0; k <= maxU; k++ {
+				// The largest value is either an x, which beats all j
+				// y-values, or a y, which beats none.
+				next[j][k] = next[j-1][k]
+				if k >= j {
+					next[j][k] += counts[j][k-j]
+				}
+			}
+		}
+		counts = next
+	}
+
+	total, below := 0.0, 0.0
+	for k, c := range counts[n2] {
+		total += c
+		if k <= u {
+			below += c
+		}
+	}
+	return below / total
+}
+
+// CompareBench pairs the results of two runs by name. Benchmarks present
+// in only one of them are skipped.
+func CompareBench(old map[string][]BenchSample, results []BenchResult) []BenchComparison {
+	var out []BenchComparison
+	for _, r := range results {
+		samples, ok := old[r.Name]
+		if !ok {
+			continue
+		}
+		base := BenchResult{Name: r.Name, Samples: samples}
+		c := BenchComparison{Name: r.Name, Old: base.Summary(), New: r.Summary()}
+		if c.Old.Mean > 0 {
+			c.Delta = (c.New.Mean - c.Old.Mean) / c.Old.Mean
+		}
+		c.P = MannWhitneyU(base.nsPerOp(), r.nsPerOp())
+		out = append(out, c)
+	}
+	return out
+}
+
+// benchLineName turns "concat/plus" into "BenchmarkConcat/plus-8", the
+// name `go test` would print with GOMAXPROCS=8.
+func benchLineName(name string) string {
+	line := "Benchmark" + strings.ToUpper(name[:1]) + name[1:]
+	if procs := runtime.GOMAXPROCS(0); procs > 1 {
+		line += "-" + strconv.Itoa(procs)
+	}
+	return line
+}
+
+func WriteBenchFile(w io.Writer, results []BenchResult) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "goos: %s\ngoarch: %s\n", runtime.GOOS, runtime.GOARCH)
+	for _, r := range results {
+		name := benchLineName(r.Name)
+		for _, s := range r.Samples {
+			fmt.Fprintf(bw, "%s\t%d\t%.2f ns/op\t%d B/op\t%d allocs/op\n", name, s.N, s.NsPerOp, s.BytesPerOp, s.AllocsPerOp)
+		}
+	}
+	return bw.Flush()
+}
+
+// ParseBenchFile reads `go test -bench` output: lines such as
+//
+//	BenchmarkConcat/plus-8   123456   9876 ns/op   512 B/op   3 allocs/op
+//
+// Every line is one sample. Other lines (goos:, PASS, ...) are ignored.
+func ParseBenchFile(r io.Reader) (map[string][]BenchSample, error) {
+	out := map[string][]BenchSample{}
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "Benchmark") || len(fields[0]) == len("Benchmark") {
+			continue
+		}
+		if len(fields) < 4 || len(fields)%2 != 0 {
+			return nil, fmt.Errorf("baris %d: format benchmark tidak valid", lineNo)
+		}
+		name := strings.TrimPrefix(fields[0], "Benchmark")
+		if i := strings.LastIndex(name, "-"); i > 0 {
+			if _, err := strconv.Atoi(name[i+1:]); err == nil {
+				name = name[:i]
+			}
+		}
+		name = strings.ToLower(name[:1]) + name[1:]
+
+		var s BenchSample
+		var err error
+		if s.N, err = strconv.Atoi(fields[1]); err != nil {
+			return nil, fmt.Errorf("baris %d: jumlah iterasi %q tidak valid", lineNo, fields[1])
+		}
+		for i := 2; i+1 < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("baris %d: nilai %q tidak valid", lineNo, fields[i])
+			}
+			switch fields[i+1] {
+			case "ns/op":
+				s.NsPerOp = v
+			case "B/op":
+				s.BytesPerOp = int64(v)
+			case "allocs/op":
+				s.AllocsPerOp = int64(v)
+			}
+		}
+		out[name] = append(out[name], s)
+	}
+	return out, scanner.Err()
+}
+
+func loadBenchFile(path string) (map[string][]BenchSample, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membuka baseline: %w", err)
+	}
+	defer f.Close()
+	return ParseBenchFile(f)
+}
+
+// SummaryTable lays the results out as a Table, so they can be printed
+// as aligned text or as markdown for the guide.
+func SummaryTable(results []BenchResult) *table.Table {
+	t := table.NewTable(
+		table.Column{Name: "benchmark", Type: table.StringColumn},
+		table.Column{Name: "ns/op", Type: table.FloatColumn},
+		table.Column{Name: "±95%", Type: table.StringColumn},
+		table.Column{Name: "stddev", Type: table.FloatColumn},
+		table.Column{Name: "B/op", Type: table.IntColumn},
+		table.Column{Name: "allocs/op", Type: table.IntColumn},
+		table.Column{Name: "n", Type: table.IntColumn},
+	)
+	for _, r := range results {
+		s := r.Summary()
+		t.AddRow(r.Name, s.Mean, formatPercent(s.CI95, s.Mean), s.StdDev, s.BytesPerOp, s.AllocsPerOp, s.N)
+	}
+	return t
+}
+
+func ComparisonTable(comparisons []BenchComparison) *table.Table {
+	t := table.NewTable(
+		table.Column{Name: "benchmark", Type: table.StringColumn},
+		table.Column{Name: "baseline ns/op", Type: table.FloatColumn},
+		table.Column{Name: "±95%", Type: table.StringColumn},
+		table.Column{Name: "sekarang ns/op", Type: table.FloatColumn},
+		table.Column{Name: "±95%", Type: table.StringColumn},
+		table.Column{Name: "delta", Type: table.StringColumn},
+		table.Column{Name: "p", Type: table.StringColumn},
+	)
+	for _, c := range comparisons {
+		delta := "~"
+		if c.P < benchAlpha {
+			delta = fmt.Sprintf("%+.1f%%", c.Delta*100)
+		}
+		t.AddRow(c.Name, c.Old.Mean, formatPercent(c.Old.CI95, c.Old.Mean),
+			c.New.Mean, formatPercent(c.New.CI95, c.New.Mean), delta,
+			fmt.Sprintf("p=%.3f n=%d+%d", c.P, c.Old.N, c.New.N))
+	}
+	return t
+}
+
+func formatPercent(part, whole float64) string {
+	if whole == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("±%.1f%%", part/whole*100)
+}
+
+func writeBenchTable(w io.Writer, t *table.Table, markdown bool) error {
+	if markdown {
+		return t.WriteMarkdown(w)
+	}
+	return t.Format(w)
+}
+
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	count := fs.Int("count", 5, "jumlah run per benchmark")
+	benchtime := fs.Duration("benchtime", 100*time.Millisecond, "lama target satu run")
+	baseline := fs.String("baseline", "", "bandingkan dengan hasil yang disimpan di FILE")
+	save := fs.String("save", "", "simpan hasil ke FILE (format go test -bench)")
+	format := fs.String("format", "text", "format tabel: text atau markdown")
+	list := fs.Bool("list", false, "tampilkan daftar set benchmark")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *list {
+		for _, s := range benchmarkSets {
+			fmt.Printf("%-10s %s\n", s.Name, s.Title)
+		}
+		return nil
+	}
+	if *count < 1 {
+		return fmt.Errorf("%w: -count harus minimal 1", errUsage)
+	}
+	if *format != "text" && *format != "markdown" {
+		return fmt.Errorf("%w: format %q tidak dikenal", errUsage, *format)
+	}
+	markdown := *format == "markdown"
+
+	sets := benchmarkSets
+	if fs.NArg() > 0 {
+		sets = nil
+		for _, name := range fs.Args() {
+			s, ok := findBenchmarkSet(name)
+			if !ok {
+				return fmt.Errorf("%w: set benchmark %q tidak ada (lihat bench -list)", errUsage, name)
+			}
+			sets = append(sets, s)
+		}
+	}
+	var old map[string][]BenchSample
+	if *baseline != "" {
+		var err error
+		if old, err = loadBenchFile(*baseline); err != nil {
+			return err
+		}
+	}
+
+	// testing.Benchmark takes its run time from the -test.benchtime flag,
+	// which only exists once testing.Init has registered it.
+	testing.Init()
+	if err := flag.Set("test.benchtime", benchtime.String()); err != nil {
+		return err
+	}
+
+	var all []BenchResult
+	for i, set := range sets {
+		if i > 0 {
+			fmt.Println()
+		}
+		if markdown {
+			fmt.Printf("### %s: %s\n\n", set.Name, set.Title)
+		} else {
+			fmt.Printf("=== %s: %s ===\n", set.Name, set.Title)
+		}
+		results := RunBenchmarkSet(set, *count, os.Stderr)
+		all = append(all, results...)
+		if err := writeBenchTable(os.Stdout, SummaryTable(results), markdown); err != nil {
+			return err
+		}
+		if old == nil {
+			continue
+		}
+		comparisons := CompareBench(old, results)
+		if len(comparisons) == 0 {
+			fmt.Println("(tidak ada data baseline untuk set ini)")
+			continue
+		}
+		fmt.Printf("\nDibandingkan dengan %s (~ berarti tidak signifikan, p >= %.2f):\n", *baseline, benchAlpha)
+		if markdown {
+			fmt.Println()
+		}
+		if err := writeBenchTable(os.Stdout, ComparisonTable(comparisons), markdown); err != nil {
+			return err
+		}
+	}
+
+	if *save != "" {
+		f, err := os.Create(*save)
+		if err != nil {
+			return fmt.Errorf("gagal membuat file hasil: %w", err)
+		}
+		if err := WriteBenchFile(f, all); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Println("\nHasil disimpan ke", *save)
+	}
+	return nil
+}
+
+// benchStatsExample works on fixed samples instead of running anything, so
+// the numbers are the same on every machine.
+func benchStatsExample() {
+	before := BenchResult{Name: "concat/plus", Samples: []BenchSample{
+		{NsPerOp: 2150}, {NsPerOp: 2210}, {NsPerOp: 2080}, {NsPerOp: 2190}, {NsPerOp: 2120},
+	}}
+	after := BenchResult{Name: "concat/plus", Samples: []BenchSample{
+		{NsPerOp: 640}, {NsPerOp: 655}, {NsPerOp: 630}, {NsPerOp: 700}, {NsPerOp: 645},
+	}}
+	noise := BenchResult{Name: "concat/plus", Samples: []BenchSample{
+		{NsPerOp: 2100}, {NsPerOp: 2230}, {NsPerOp: 2160}, {NsPerOp: 2090}, {NsPerOp: 2200},
+	}}
+
+	s := before.Summary()
+	logf("Sebelum: mean %.1f ns/op, stddev %.1f, CI 95%% [%.1f, %.1f]\n", s.Mean, s.StdDev, s.Mean-s.CI95, s.Mean+s.CI95)
+	logf("Mann-Whitney sebelum vs sesudah: p = %.4f\n", MannWhitneyU(before.nsPerOp(), after.nsPerOp()))
+	logf("Mann-Whitney sebelum vs run ulang: p = %.4f (tidak signifikan)\n", MannWhitneyU(before.nsPerOp(), noise.nsPerOp()))
+
+	baseline := map[string][]BenchSample{"concat/plus": before.Samples}
+	logln("Tabel markdown:")
+	logOutput(func(w io.Writer) { ComparisonTable(CompareBench(baseline, []BenchResult{after})).WriteMarkdown(w) })
+	logln("(Jalankan `bench` untuk mengukur set benchmark sungguhan.)")
+}
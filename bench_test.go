@@ -0,0 +1,173 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExactUCDF(t *testing.T) {
+	// For n1 = n2 = 3 the 20 orderings give U = 0…9 with counts
+	// 1 1 2 3 3 3 3 2 1 1.
+	for u, want := range []float64{1, 2, 4, 7, 10, 13, 16, 18, 19, 20} {
+		if got := exactUCDF(3, 3, u); math.Abs(got-want/20) > 1e-12 {
+			t.Errorf("exactUCDF(3, 3, %d) = %v, mau %v/20", u, got, want)
+		}
+	}
+
+	// Critical values of the textbook Mann-Whitney table, two-sided
+	// α = 0.05: U at or below the value is significant, one above is not.
+	tests := []struct {
+		n1, n2, crit int
+		below, total float64
+	}{
+		{4, 4, 0, 1, 70},
+		{5, 5, 2, 4, 252},
+		{6, 6, 5, 19, 924},
+		{3, 7, 1, 2, 120},
+		{8, 8, 13, 321, 12870},
+		{10, 10, 23, 3996, 184756},
+	}
+	for _, tt := range tests {
+		p := exactUCDF(tt.n1, tt.n2, tt.crit)
+		if math.Abs(p-tt.below/tt.total) > 1e-12 {
+			t.Errorf("exactUCDF(%d, %d, %d) = %v, mau %v/%v", tt.n1, tt.n2, tt.crit, p, tt.below, tt.total)
+		}
+		if 2*p > benchAlpha {
+			t.Errorf("n1=%d n2=%d: U=%d tidak signifikan (p=%.4f)", tt.n1, tt.n2, tt.crit, 2*p)
+		}
+		if p := 2 * exactUCDF(tt.n1, tt.n2, tt.crit+1); p <= benchAlpha {
+			t.Errorf("n1=%d n2=%d: U=%d signifikan (p=%.4f)", tt.n1, tt.n2, tt.crit+1, p)
+		}
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		want float64
+	}{
+		{"terpisah, 3 lawan 3", []float64{1, 2, 3}, []float64{4, 5, 6}, 0.1},
+		{"terpisah, 5 lawan 5", []float64{10, 11, 12, 13, 14}, []float64{20, 21, 22, 23, 24}, 2.0 / 252},
+		{"urutan tidak penting", []float64{24, 20, 22, 21, 23}, []float64{14, 10, 12, 11, 13}, 2.0 / 252},
+		{"bercampur", []float64{1, 4, 5}, []float64{2, 3, 6}, 1},
+		{"U = 2 dari 5 lawan 5", []float64{1, 2, 3, 4, 7}, []float64{5, 6, 8, 9, 10}, 2 * 4.0 / 252},
+		// Ties switch to the normal approximation with tie correction
+		// and continuity correction: U = 3.5, z = -2.2697.
+		{"ada nilai kembar", []float64{1, 2, 2, 3, 4, 4}, []float64{3, 4, 5, 5, 6, 7}, 0.023223192940087675},
+		{"semua sama", []float64{5, 5, 5}, []float64{5, 5, 5}, 1},
+		{"sampel kosong", nil, []float64{1, 2}, 1},
+	}
+	for _, tt := range tests {
+		if got := MannWhitneyU(tt.x, tt.y); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: MannWhitneyU = %v, mau %v", tt.name, got, tt.want)
+		}
+		if got, back := MannWhitneyU(tt.x, tt.y), MannWhitneyU(tt.y, tt.x); math.Abs(got-back) > 1e-12 {
+			t.Errorf("%s: tidak simetris, %v lawan %v", tt.name, got, back)
+		}
+	}
+}
+
+func TestBenchSummaryCI(t *testing.T) {
+	r := BenchResult{Samples: []BenchSample{{NsPerOp: 10}, {NsPerOp: 12}, {NsPerOp: 14, BytesPerOp: 64, AllocsPerOp: 2}}}
+	s := r.Summary()
+	// Mean 12, s = 2, t(0.975, 2) = 4.303: 4.303 * 2 / √3.
+	if s.N != 3 || s.Mean != 12 || s.StdDev != 2 {
+		t.Errorf("Summary() = %+v, mau N=3 Mean=12 StdDev=2", s)
+	}
+	if math.Abs(s.CI95-4.968676) > 1e-6 {
+		t.Errorf("CI95 = %v, mau 4.968676", s.CI95)
+	}
+	if s.BytesPerOp != 64 || s.AllocsPerOp != 2 {
+		t.Errorf("B/op dan allocs/op = %d, %d, mau dari sampel terakhir 64, 2", s.BytesPerOp, s.AllocsPerOp)
+	}
+
+	// Past 30 degrees of freedom the normal 1.96 takes over.
+	var many BenchResult
+	for i := range 41 {
+		many.Samples = append(many.Samples, BenchSample{NsPerOp: float64(100 + i%2*2)})
+	}
+	s = many.Summary()
+	if want := 1.96 * s.StdDev / math.Sqrt(41); math.Abs(s.CI95-want) > 1e-12 {
+		t.Errorf("CI95 dengan 41 sampel = %v, mau %v", s.CI95, want)
+	}
+	if s := (BenchResult{Samples: []BenchSample{{NsPerOp: 5}}}).Summary(); s.CI95 != 0 || s.StdDev != 0 {
+		t.Errorf("satu sampel: %+v, mau CI95 dan StdDev 0", s)
+	}
+}
+
+const benchstatInput = `goos: linux
+goarch: amd64
+pkg: belajar-golang
+cpu: Intel(R) Xeon(R) CPU @ 2.20GHz
+BenchmarkConcat/plus-8   	  123456	      9876 ns/op	     512 B/op	       3 allocs/op
+BenchmarkConcat/plus-8   	  120000	      9900.5 ns/op	     512 B/op	       3 allocs/op
+BenchmarkLookup/map      	 1000000	        12.3 ns/op
+BenchmarkDecode-16       	    5000	       300 ns/op	 120.50 MB/s	      64 B/op	       1 allocs/op
+BenchmarkName-with-dash-4	      10	       100 ns/op
+PASS
+ok  	belajar-golang	3.210s
+`
+
+func TestParseBenchFile(t *testing.T) {
+	got, err := ParseBenchFile(strings.NewReader(benchstatInput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]BenchSample{
+		"concat/plus": {
+			{N: 123456, NsPerOp: 9876, BytesPerOp: 512, AllocsPerOp: 3},
+			{N: 120000, NsPerOp: 9900.5, BytesPerOp: 512, AllocsPerOp: 3},
+		},
+		"lookup/map":     {{N: 1000000, NsPerOp: 12.3}},
+		"decode":         {{N: 5000, NsPerOp: 300, BytesPerOp: 64, AllocsPerOp: 1}},
+		"name-with-dash": {{N: 10, NsPerOp: 100}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseBenchFile =\n  %+v\nmau\n  %+v", got, want)
+	}
+
+	for _, bad := range []string{
+		"BenchmarkX-8 10",
+		"BenchmarkX-8 banyak 10 ns/op",
+		"BenchmarkX-8 10 cepat ns/op",
+		"BenchmarkX-8 10 5 ns/op 3",
+	} {
+		if _, err := ParseBenchFile(strings.NewReader(bad)); err == nil || !strings.HasPrefix(err.Error(), "baris 1:") {
+			t.Errorf("ParseBenchFile(%q) = %v, mau error di baris 1", bad, err)
+		}
+	}
+}
+
+func TestBenchFileRoundTrip(t *testing.T) {
+	results := []BenchResult{
+		{Name: "concat/builder", Samples: []BenchSample{{N: 1000, NsPerOp: 1234.5, BytesPerOp: 48, AllocsPerOp: 1}, {N: 1000, NsPerOp: 1200, BytesPerOp: 48, AllocsPerOp: 1}}},
+		{Name: "counter/atomic", Samples: []BenchSample{{N: 5000000, NsPerOp: 2.25}}},
+	}
+	var sb strings.Builder
+	if err := WriteBenchFile(&sb, results); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseBenchFile(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if !reflect.DeepEqual(got[r.Name], r.Samples) {
+			t.Errorf("%s setelah ditulis dan dibaca = %+v, mau %+v", r.Name, got[r.Name], r.Samples)
+		}
+	}
+
+	comparisons := CompareBench(got, []BenchResult{
+		{Name: "concat/builder", Samples: []BenchSample{{NsPerOp: 600}, {NsPerOp: 610}}},
+		{Name: "baru", Samples: []BenchSample{{NsPerOp: 1}}},
+	})
+	if len(comparisons) != 1 || comparisons[0].Name != "concat/builder" {
+		t.Fatalf("CompareBench = %+v, mau hanya concat/builder", comparisons)
+	}
+	if c := comparisons[0]; math.Abs(c.Delta-(605-1217.25)/1217.25) > 1e-12 || c.P != 2.0/6 {
+		t.Errorf("CompareBench = Delta %v, P %v, mau Delta %v, P 1/3", c.Delta, c.P, (605-1217.25)/1217.25)
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ByteSize is a number of bytes that reads and writes human strings.
+// Parsing follows the dd(1) convention:
+//
+//	"10k", "10K"     binary  (10 × 1024)
+//	"10KiB"          binary  (10 × 1024)
+//	"10kB", "10KB"   SI      (10 × 1000)
+//
+// so the IEC suffixes always mean powers of 1024 and the "B" suffixes
+// always mean powers of 1000, while a lone letter keeps the traditional
+// binary meaning of the KB/MB/GB/TB constants.
+type ByteSize int64
+
+const (
+	PB = 1 << (10 * 5)
+	EB = 1 << (10 * 6)
+)
+
+type ByteSizeFormat struct {
+	SI        bool
+	Precision int
+}
+
+var ErrByteSizeOverflow = errors.New("ukuran melebihi batas int64")
+
+var (
+	iecUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	siUnits  = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+)
+
+var byteSizeMultipliers = map[string]int64{
+	"":    1,
+	"b":   1,
+	"k":   KB,
+	"m":   MB,
+	"g":   GB,
+	"t":   TB,
+	"p":   PB,
+	"e":   EB,
+	"kib": KB,
+	"mib": MB,
+	"gib": GB,
+	"tib": TB,
+	"pib": PB,
+	"eib": EB,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"eb":  1e18,
+}
+
+func ParseByteSize(s string) (ByteSize, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return 0, fmt.Errorf("ukuran kosong")
+	}
+	split := strings.IndexFunc(text, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.' && r != '-' && r != '+' && r != '_'
+	})
+	if split < 0 {
+		split = len(text)
+	}
+	number := strings.ReplaceAll(text[:split], "_", "")
+	unit := strings.ToLower(strings.TrimSpace(text[split:]))
+
+	mult, ok := byteSizeMultipliers[unit]
+	if !ok {
+		return 0, fmt.Errorf("satuan ukuran tidak dikenal %q pada %q", text[split:], s)
+	}
+	if number == "" {
+		return 0, fmt.Errorf("ukuran %q tidak memiliki angka", s)
+	}
+
+	// Whole numbers stay in integer arithmetic so that sizes near the
+	// int64 limit do not lose precision through float64.
+	if !strings.Contains(number, ".") {
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, fmt.Errorf("%q: %w", s, ErrByteSizeOverflow)
+			}
+			return 0, fmt.Errorf("angka tidak valid pada %q", s)
+		}
+		if n != 0 && (n > math.MaxInt64/mult || n < math.MinInt64/mult) {
+			return 0, fmt.Errorf("%q: %w", s, ErrByteSizeOverflow)
+		}
+		return ByteSize(n * mult), nil
+	}
+
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("angka tidak valid pada %q", s)
+	}
+	bytes := math.Round(f * float64(mult))
+	if bytes >= math.MaxInt64 || bytes < math.MinInt64 {
+		return 0, fmt.Errorf("%q: %w", s, ErrByteSizeOverflow)
+	}
+	return ByteSize(bytes), nil
+}
+
+func (b ByteSize) Format(opts ByteSizeFormat) string {
+	base, units := 1024.0, iecUnits
+	if opts.SI {
+		base, units = 1000.0, siUnits
+	}
+
+	value := float64(b)
+	i := 0
+	for math.Abs(value) >= base && i < len(units)-1 {
+		value /= base
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d %s", int64(b), units[0])
+	}
+
+	text := strconv.FormatFloat(value, 'f', opts.Precision, 64)
+	if strings.Contains(text, ".") {
+		text = strings.TrimRight(strings.TrimRight(text, "0"), ".")
+	}
+	return text + " " + units[i]
+}
+
+func (b ByteSize) String() string {
+	return b.Format(ByteSizeFormat{Precision: 2})
+}
+
+func (b *ByteSize) Set(s string) error {
+	v, err := ParseByteSize(s)
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// exact returns b in the largest unit that divides it without remainder,
+// binary or SI, so that MarshalText and MarshalJSON survive a round trip
+// unchanged and 2e12 reads "2 TB" rather than "1953125000 KiB".
+func (b ByteSize) exact() string {
+	n := int64(b)
+	if n == 0 {
+		return "0 B"
+	}
+	best, bestUnit := int64(1), "B"
+	for _, unit := range slices.Concat(iecUnits[1:], siUnits[1:]) {
+		mult := byteSizeMultipliers[strings.ToLower(unit)]
+		if n%mult == 0 && mult > best {
+			best, bestUnit = mult, unit
+		}
+	}
+	return fmt.Sprintf("%d %s", n/best, bestUnit)
+}
+
+func (b ByteSize) MarshalText() ([]byte, error) {
+	return []byte(b.exact()), nil
+}
+
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	return b.Set(string(text))
+}
+
+// MarshalJSON writes the exact human form; UnmarshalJSON also accepts a
+// plain number of bytes.
+func (b ByteSize) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.exact())
+}
+
+func (b *ByteSize) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return b.Set(s)
+	}
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("ukuran harus berupa string atau angka: %s", data)
+	}
+	*b = ByteSize(n)
+	return nil
+}
+
+var _ flag.Value = (*ByteSize)(nil)
+
+func byteSizeExample() {
+	logln("1 GB (konstanta) =", ByteSize(GB))
+	for _, s := range []string{"1.5GiB", "200 MB", "10k", "512", "2 TB", "9EiB"} {
+		size, err := ParseByteSize(s)
+		if err != nil {
+			logf("  %-8s -> error: %v\n", s, err)
+			continue
+		}
+		logf("  %-8s -> %d byte, IEC: %s, SI: %s\n", s, int64(size), size,
+			size.Format(ByteSizeFormat{SI: true, Precision: 1}))
+	}
+
+	var cfg Config
+	if err := json.Unmarshal([]byte(`{"Retries": 3, "CacheLimit": "256MiB"}`), &cfg); err != nil {
+		logln("Gagal membaca konfigurasi:", err)
+		return
+	}
+	logln("CacheLimit dari JSON:", cfg.CacheLimit)
+
+	fs := flag.NewFlagSet("contoh", flag.ContinueOnError)
+	fs.Var(&cfg.CacheLimit, "cache-limit", "batas cache, mis. 512MiB")
+	if err := fs.Parse([]string{"-cache-limit=1.5GiB"}); err != nil {
+		logln("Gagal membaca flag:", err)
+		return
+	}
+	logln("CacheLimit dari flag:", cfg.CacheLimit)
+
+	out, _ := json.Marshal(cfg)
+	logln("Config sebagai JSON:", string(out))
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ByteSize
+	}{
+		{"512", 512},
+		{" 1_000 ", 1000},
+		{"10k", 10 * KB},
+		{"10K", 10 * KB},
+		{"10KiB", 10 * KB},
+		{"10kib", 10 * KB},
+		{"10kB", 10_000},
+		{"10KB", 10_000},
+		{"200 MB", 200_000_000}, // SI, bukan 200 × konstanta MB
+		{"200 MiB", 200 * MB},
+		{"200m", 200 * MB},
+		{"1.5GiB", 1536 * MB},
+		{"0.5k", 512},
+		{"2 TB", 2_000_000_000_000},
+		{"-1k", -KB},
+		{"7EiB", 7 * EB},
+		{"9223372036854775807", math.MaxInt64},
+	}
+	for _, tt := range tests {
+		got, err := ParseByteSize(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseByteSize(%q) = %d, %v, mau %d", tt.in, got, err, tt.want)
+		}
+	}
+
+	// MB sebagai konstanta adalah 1 << 20, tapi "1MB" adalah SI.
+	if got, _ := ParseByteSize("1MB"); got == MB {
+		t.Errorf("ParseByteSize(\"1MB\") = %d, sama dengan konstanta MB", got)
+	}
+}
+
+func TestParseByteSizeErrors(t *testing.T) {
+	overflow := []string{"8EiB", "9223372036854775808", "10EB", "9.5EiB", "-9EiB"}
+	for _, in := range overflow {
+		if _, err := ParseByteSize(in); !errors.Is(err, ErrByteSizeOverflow) {
+			t.Errorf("ParseByteSize(%q) = %v, mau ErrByteSizeOverflow", in, err)
+		}
+	}
+	for _, in := range []string{"", "  ", "k", "10x", "10 KBB", "abc", "1.2.3k", "1e3"} {
+		if got, err := ParseByteSize(in); err == nil {
+			t.Errorf("ParseByteSize(%q) = %d, mau error", in, got)
+		}
+	}
+}
+
+func TestByteSizeFormat(t *testing.T) {
+	tests := []struct {
+		size ByteSize
+		opts ByteSizeFormat
+		want string
+	}{
+		{0, ByteSizeFormat{}, "0 B"},
+		{1023, ByteSizeFormat{Precision: 2}, "1023 B"},
+		{1536, ByteSizeFormat{Precision: 2}, "1.5 KiB"},
+		{-2 * KB, ByteSizeFormat{Precision: 2}, "-2 KiB"},
+		{GB, ByteSizeFormat{Precision: 2}, "1 GiB"},
+		{1000, ByteSizeFormat{SI: true, Precision: 1}, "1 kB"},
+		{1_500_000, ByteSizeFormat{SI: true, Precision: 2}, "1.5 MB"},
+		{MB, ByteSizeFormat{SI: true, Precision: 2}, "1.05 MB"},
+		{MB, ByteSizeFormat{Precision: 2}, "1 MiB"},
+		{1_234_567, ByteSizeFormat{Precision: 0}, "1 MiB"},
+		{math.MaxInt64, ByteSizeFormat{Precision: 2}, "8 EiB"},
+	}
+	for _, tt := range tests {
+		if got := tt.size.Format(tt.opts); got != tt.want {
+			t.Errorf("ByteSize(%d).Format(%+v) = %q, mau %q", int64(tt.size), tt.opts, got, tt.want)
+		}
+	}
+	if got := ByteSize(1536).String(); got != "1.5 KiB" {
+		t.Errorf("String() = %q, mau \"1.5 KiB\"", got)
+	}
+}
+
+func TestByteSizeTextRoundTrip(t *testing.T) {
+	tests := []struct {
+		size ByteSize
+		text string
+	}{
+		{0, "0 B"},
+		{1536, "1536 B"},
+		{10 * KB, "10 KiB"},
+		{MB, "1 MiB"},
+		{200_000_000, "200 MB"},
+		{3000, "3 kB"},
+		{-4 * GB, "-4 GiB"},
+		{7 * EB, "7 EiB"},
+		{math.MaxInt64, "9223372036854775807 B"},
+	}
+	for _, tt := range tests {
+		text, err := tt.size.MarshalText()
+		if err != nil || string(text) != tt.text {
+			t.Errorf("MarshalText(%d) = %q, %v, mau %q", int64(tt.size), text, err, tt.text)
+		}
+		var back ByteSize
+		if err := back.UnmarshalText(text); err != nil || back != tt.size {
+			t.Errorf("UnmarshalText(%q) = %d, %v, mau %d", text, back, err, tt.size)
+		}
+	}
+}
+
+func TestByteSizeJSON(t *testing.T) {
+	type limits struct {
+		Cache ByteSize
+		Disk  ByteSize
+	}
+	in := limits{Cache: 256 * MB, Disk: 2_000_000_000_000}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Cache":"256 MiB","Disk":"2 TB"}`; string(data) != want {
+		t.Errorf("json.Marshal = %s, mau %s", data, want)
+	}
+	var out limits
+	if err := json.Unmarshal(data, &out); err != nil || out != in {
+		t.Errorf("json.Unmarshal(%s) = %+v, %v, mau %+v", data, out, err, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Cache": 4096, "Disk": "1.5GiB"}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Cache != 4096 || out.Disk != 1536*MB {
+		t.Errorf("angka dan string campuran = %+v", out)
+	}
+	for _, bad := range []string{`{"Cache": true}`, `{"Cache": "10x"}`, `{"Cache": 1.5}`} {
+		if err := json.Unmarshal([]byte(bad), &out); err == nil {
+			t.Errorf("json.Unmarshal(%s) tidak menghasilkan error", bad)
+		}
+	}
+}
+
+func TestByteSizeFlag(t *testing.T) {
+	var size ByteSize
+	fs := flag.NewFlagSet("uji", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&size, "size", "")
+	if err := fs.Parse([]string{"-size=1.5GiB"}); err != nil || size != 1536*MB {
+		t.Errorf("-size=1.5GiB = %d, %v, mau %d", size, err, 1536*MB)
+	}
+	if err := fs.Parse([]string{"-size", "64kB"}); err != nil || size != 64_000 {
+		t.Errorf("-size 64kB = %d, %v, mau 64000", size, err)
+	}
+	if err := fs.Parse([]string{"-size=banyak"}); err == nil {
+		t.Error("-size=banyak tidak menghasilkan error")
+	}
+}
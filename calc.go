@@ -0,0 +1,838 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"belajar-golang/internal/geometry"
+)
+
+// The calculator is a classic three-stage pipeline: the tokenizer turns
+// text into tokens, a Pratt parser turns tokens into a tree, and the
+// evaluator walks the tree. Integers stay integers (with divide's
+// truncating semantics) until they meet a float; with BigInts enabled they
+// grow into math/big instead of overflowing.
+
+type numKind int
+
+const (
+	numInt numKind = iota
+	numFloat
+	numBig
+)
+
+type Number struct {
+	kind numKind
+	i    int64
+	f    float64
+	b    *big.Int
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type calcToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type CalcError struct {
+	Pos int
+	Msg string
+	Err error
+}
+
+type calcNode interface {
+	pos() int
+}
+
+type (
+	numberNode struct {
+		at  int
+		val Number
+	}
+	varNode struct {
+		at   int
+		name string
+	}
+	unaryNode struct {
+		at      int
+		op      string
+		operand calcNode
+	}
+	binaryNode struct {
+		at          int
+		op          string
+		left, right calcNode
+	}
+	callNode struct {
+		at   int
+		name string
+		args []calcNode
+	}
+	assignNode struct {
+		at    int
+		name  string
+		value calcNode
+	}
+)
+
+type CalcFunc struct {
+	Arity int // -1 accepts any number of arguments
+	Fn    func(args []Number) (Number, error)
+}
+
+type Calculator struct {
+	BigInts bool
+	vars    map[string]Number
+	funcs   map[string]CalcFunc
+}
+
+var (
+	ErrIntOverflow = errors.New("hasil melebihi batas int64")
+	errNotInteger  = errors.New("operasi ini hanya untuk bilangan bulat")
+)
+
+func init() {
+	registerCommand(command{
+		Name:  "calc",
+		Usage: "calc [-big] [EKSPRESI]   (tanpa ekspresi: mode REPL interaktif)",
+		Run:   calcCommand,
+	})
+}
+
+func IntNumber(i int64) Number {
+	return Number{kind: numInt, i: i}
+}
+
+func FloatNumber(f float64) Number {
+	return Number{kind: numFloat, f: f}
+}
+
+func BigNumber(b *big.Int) Number {
+	return Number{kind: numBig, b: b}.normalize()
+}
+
+func (n numberNode) pos() int { return n.at }
+func (n varNode) pos() int    { return n.at }
+func (n unaryNode) pos() int  { return n.at }
+func (n binaryNode) pos() int { return n.at }
+func (n callNode) pos() int   { return n.at }
+func (n assignNode) pos() int { return n.at }
+
+func (n Number) IsInt() bool {
+	return n.kind != numFloat
+}
+
+func (e *CalcError) Unwrap() error {
+	return e.Err
+}
+
+func (t calcToken) is(s string) bool {
+	return t.kind != tokNumber && t.text == s
+}
+
+// normalize turns big values that fit into an int64 back into plain ints.
+func (n Number) normalize() Number {
+	if n.kind == numBig && n.b.IsInt64() {
+		return IntNumber(n.b.Int64())
+	}
+	return n
+}
+
+func (n Number) Float() float64 {
+	switch n.kind {
+	case numInt:
+		return float64(n.i)
+	case numBig:
+		f, _ := new(big.Float).SetInt(n.b).Float64()
+		return f
+	}
+	return n.f
+}
+
+func (n Number) bigInt() *big.Int {
+	if n.kind == numBig {
+		return n.b
+	}
+	return big.NewInt(n.i)
+}
+
+func (n Number) String() string {
+	switch n.kind {
+	case numInt:
+		return strconv.FormatInt(n.i, 10)
+	case numBig:
+		return n.b.String()
+	}
+	return strconv.FormatFloat(n.f, 'g', -1, 64)
+}
+
+func (e *CalcError) Error() string {
+	if e.Err != nil && e.Msg == "" {
+		return fmt.Sprintf("kolom %d: %v", e.Pos+1, e.Err)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("kolom %d: %s: %v", e.Pos+1, e.Msg, e.Err)
+	}
+	return fmt.Sprintf("kolom %d: %s", e.Pos+1, e.Msg)
+}
+
+// Caret draws a marker under the column the error points at.
+func (e *CalcError) Caret(src string) string {
+	return src + "\n" + strings.Repeat(" ", e.Pos) + "^"
+}
+
+func tokenize(src string) ([]calcToken, error) {
+	var tokens []calcToken
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == '_') {
+				i++
+			}
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					i = j
+					for i < len(runes) && unicode.IsDigit(runes[i]) {
+						i++
+					}
+				}
+			}
+			tokens = append(tokens, calcToken{tokNumber, string(runes[start:i]), start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, calcToken{tokIdent, string(runes[start:i]), start})
+		case strings.ContainsRune("+-*/%^=", r):
+			tokens = append(tokens, calcToken{tokOp, string(r), i})
+			i++
+		case r == '(':
+			tokens = append(tokens, calcToken{tokLParen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, calcToken{tokRParen, ")", i})
+			i++
+		case r == ',':
+			tokens = append(tokens, calcToken{tokComma, ",", i})
+			i++
+		default:
+			return nil, &CalcError{Pos: i, Msg: fmt.Sprintf("karakter tidak dikenal %q", r)}
+		}
+	}
+	return append(tokens, calcToken{tokEOF, "", len(runes)}), nil
+}
+
+func parseNumberLiteral(tok calcToken) (Number, error) {
+	text := strings.ReplaceAll(tok.text, "_", "")
+	if !strings.ContainsAny(text, ".eE") {
+		if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return IntNumber(i), nil
+		}
+		if b, ok := new(big.Int).SetString(text, 10); ok {
+			return BigNumber(b), nil
+		}
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return Number{}, &CalcError{Pos: tok.pos, Msg: fmt.Sprintf("angka tidak valid %q", tok.text)}
+	}
+	return FloatNumber(f), nil
+}
+
+type calcParser struct {
+	tokens []calcToken
+	pos    int
+}
+
+// Binding powers: a higher number binds tighter. "^" is right-associative
+// and binds tighter than unary minus, so -2^2 is -(2^2).
+var infixPower = map[string]int{
+	"=": 1,
+	"+": 10, "-": 10,
+	"*": 20, "/": 20, "%": 20,
+	"^": 40,
+}
+
+const prefixPower = 30
+
+func (p *calcParser) peek() calcToken {
+	return p.tokens[p.pos]
+}
+
+func (p *calcParser) next() calcToken {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func parseExpression(src string) (calcNode, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &calcParser{tokens: tokens}
+	node, err := p.expr(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &CalcError{Pos: t.pos, Msg: fmt.Sprintf("token tidak terduga %q", t.text)}
+	}
+	return node, nil
+}
+
+func (p *calcParser) expr(minPower int) (calcNode, error) {
+	left, err := p.prefix()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		power, ok := infixPower[t.text]
+		if t.kind != tokOp || !ok || power <= minPower {
+			return left, nil
+		}
+		p.next()
+
+		if t.text == "=" {
+			v, isVar := left.(varNode)
+			if !isVar {
+				return nil, &CalcError{Pos: t.pos, Msg: "sisi kiri '=' harus berupa nama variabel"}
+			}
+			value, err := p.expr(power - 1)
+			if err != nil {
+				return nil, err
+			}
+			left = assignNode{at: v.at, name: v.name, value: value}
+			continue
+		}
+
+		rightPower := power
+		if t.text == "^" {
+			rightPower = power - 1
+		}
+		right, err := p.expr(rightPower)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{at: t.pos, op: t.text, left: left, right: right}
+	}
+}
+
+func (p *calcParser) prefix() (calcNode, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokNumber:
+		n, err := parseNumberLiteral(t)
+		if err != nil {
+			return nil, err
+		}
+		return numberNode{at: t.pos, val: n}, nil
+	case t.kind == tokIdent:
+		if p.peek().kind == tokLParen {
+			return p.call(t)
+		}
+		return varNode{at: t.pos, name: t.text}, nil
+	case t.is("-"), t.is("+"):
+		operand, err := p.expr(prefixPower)
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{at: t.pos, op: t.text, operand: operand}, nil
+	case t.kind == tokLParen:
+		inner, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, &CalcError{Pos: closing.pos, Msg: "diharapkan ')'"}
+		}
+		return inner, nil
+	case t.kind == tokEOF:
+		return nil, &CalcError{Pos: t.pos, Msg: "ekspresi tidak lengkap"}
+	}
+	return nil, &CalcError{Pos: t.pos, Msg: fmt.Sprintf("token tidak terduga %q", t.text)}
+}
+
+func (p *calcParser) call(name calcToken) (calcNode, error) {
+	p.next() // (
+	node := callNode{at: name.pos, name: name.text}
+	if p.peek().kind == tokRParen {
+		p.next()
+		return node, nil
+	}
+	for {
+		arg, err := p.expr(0)
+		if err != nil {
+			return nil, err
+		}
+		node.args = append(node.args, arg)
+
+		t := p.next()
+		if t.kind == tokRParen {
+			return node, nil
+		}
+		if t.kind != tokComma {
+			return nil, &CalcError{Pos: t.pos, Msg: "diharapkan ',' atau ')'"}
+		}
+	}
+}
+
+func NewCalculator() *Calculator {
+	c := &Calculator{
+		vars:  map[string]Number{"pi": FloatNumber(math.Pi), "e": FloatNumber(math.E)},
+		funcs: map[string]CalcFunc{},
+	}
+	for name, fn := range map[string]func(float64) float64{
+		"sqrt": math.Sqrt, "sin": math.Sin, "cos": math.Cos, "tan": math.Tan,
+		"ln": math.Log, "log10": math.Log10, "exp": math.Exp,
+		"floor": math.Floor, "ceil": math.Ceil,
+	} {
+		c.DefineFloat(name, fn)
+	}
+	c.Define("abs", CalcFunc{Arity: 1, Fn: func(args []Number) (Number, error) {
+		n := args[0]
+		switch n.kind {
+		case numFloat:
+			return FloatNumber(math.Abs(n.f)), nil
+		case numBig:
+			return BigNumber(new(big.Int).Abs(n.b)), nil
+		}
+		if n.i == math.MinInt64 {
+			if !c.BigInts {
+				return Number{}, ErrIntOverflow
+			}
+			return BigNumber(new(big.Int).Neg(n.bigInt())), nil
+		}
+		return IntNumber(max(n.i, -n.i)), nil
+	}})
+	c.Define("max", CalcFunc{Arity: -1, Fn: func(args []Number) (Number, error) {
+		return pickNumber(args, 1)
+	}})
+	c.Define("min", CalcFunc{Arity: -1, Fn: func(args []Number) (Number, error) {
+		return pickNumber(args, -1)
+	}})
+	return c
+}
+
+func pickNumber(args []Number, want int) (Number, error) {
+	if len(args) == 0 {
+		return Number{}, errors.New("butuh minimal satu argumen")
+	}
+	best := args[0]
+	for _, n := range args[1:] {
+		if compareNumbers(n, best) == want {
+			best = n
+		}
+	}
+	return best, nil
+}
+
+func compareNumbers(a, b Number) int {
+	if a.IsInt() && b.IsInt() {
+		return a.bigInt().Cmp(b.bigInt())
+	}
+	af, bf := a.Float(), b.Float()
+	switch {
+	case af < bf:
+		return -1
+	case af > bf:
+		return 1
+	}
+	return 0
+}
+
+// Define registers a Go function under name; calls with the wrong number
+// of arguments are rejected before fn runs.
+func (c *Calculator) Define(name string, fn CalcFunc) {
+	c.funcs[name] = fn
+}
+
+func (c *Calculator) DefineFloat(name string, fn func(float64) float64) {
+	c.Define(name, CalcFunc{Arity: 1, Fn: func(args []Number) (Number, error) {
+		return FloatNumber(fn(args[0].Float())), nil
+	}})
+}
+
+func (c *Calculator) Set(name string, value Number) {
+	c.vars[name] = value
+}
+
+func (c *Calculator) Vars() map[string]Number {
+	out := make(map[string]Number, len(c.vars))
+	for k, v := range c.vars {
+		out[k] = v
+	}
+	return out
+}
+
+func (c *Calculator) Eval(src string) (Number, error) {
+	node, err := parseExpression(src)
+	if err != nil {
+		return Number{}, err
+	}
+	v, err := c.eval(node)
+	if err == nil && v.kind == numBig && !c.BigInts {
+		return Number{}, &CalcError{Pos: node.pos(), Err: ErrIntOverflow}
+	}
+	return v, err
+}
+
+func (c *Calculator) eval(node calcNode) (Number, error) {
+	switch n := node.(type) {
+	case numberNode:
+		return n.val, nil
+	case varNode:
+		v, ok := c.vars[n.name]
+		if !ok {
+			return Number{}, &CalcError{Pos: n.at, Msg: fmt.Sprintf("variabel %q belum didefinisikan", n.name)}
+		}
+		return v, nil
+	case assignNode:
+		v, err := c.eval(n.value)
+		if err != nil {
+			return Number{}, err
+		}
+		if v.kind == numBig && !c.BigInts {
+			return Number{}, &CalcError{Pos: n.value.pos(), Err: ErrIntOverflow}
+		}
+		c.vars[n.name] = v
+		return v, nil
+	case unaryNode:
+		v, err := c.eval(n.operand)
+		if err != nil {
+			return Number{}, err
+		}
+		if n.op == "+" {
+			return v, nil
+		}
+		v, err = c.binary("-", IntNumber(0), v)
+		return v, positioned(n.at, err)
+	case binaryNode:
+		left, err := c.eval(n.left)
+		if err != nil {
+			return Number{}, err
+		}
+		right, err := c.eval(n.right)
+		if err != nil {
+			return Number{}, err
+		}
+		v, err := c.binary(n.op, left, right)
+		return v, positioned(n.at, err)
+	case callNode:
+		fn, ok := c.funcs[n.name]
+		if !ok {
+			return Number{}, &CalcError{Pos: n.at, Msg: fmt.Sprintf("fungsi %q tidak dikenal", n.name)}
+		}
+		if fn.Arity >= 0 && len(n.args) != fn.Arity {
+			return Number{}, &CalcError{Pos: n.at, Msg: fmt.Sprintf("fungsi %s butuh %d argumen, diberi %d", n.name, fn.Arity, len(n.args))}
+		}
+		args := make([]Number, len(n.args))
+		for i, a := range n.args {
+			v, err := c.eval(a)
+			if err != nil {
+				return Number{}, err
+			}
+			args[i] = v
+		}
+		v, err := fn.Fn(args)
+		return v, positioned(n.at, err)
+	}
+	return Number{}, fmt.Errorf("node tidak dikenal %T", node)
+}
+
+// positioned attaches a column to errors from arithmetic and Go
+// functions, which know nothing about the source text.
+func positioned(pos int, err error) error {
+	var ce *CalcError
+	if err == nil || errors.As(err, &ce) {
+		return err
+	}
+	return &CalcError{Pos: pos, Err: err}
+}
+
+func (c *Calculator) binary(op string, a, b Number) (Number, error) {
+	if !a.IsInt() || !b.IsInt() {
+		return floatBinary(op, a.Float(), b.Float())
+	}
+	if a.kind == numInt && b.kind == numInt {
+		v, err := intBinary(op, a.i, b.i)
+		if !errors.Is(err, ErrIntOverflow) || !c.BigInts {
+			return v, err
+		}
+	}
+	if !c.BigInts && op == "^" && a.bigInt().BitLen() > 1 && b.bigInt().Cmp(big.NewInt(63)) > 0 {
+		return Number{}, ErrIntOverflow
+	}
+	v, err := bigBinary(op, a.bigInt(), b.bigInt())
+	// Without BigInts a literal such as 9223372036854775808 is still
+	// parsed as big, so that -9223372036854775808 can come out as an
+	// int64; only results that stay big are overflows.
+	if err == nil && v.kind == numBig && !c.BigInts {
+		return Number{}, ErrIntOverflow
+	}
+	return v, err
+}
+
+func intBinary(op string, a, b int64) (Number, error) {
+	switch op {
+	case "+":
+		if sum := a + b; !geometry.AddOverflows(a, b, sum) {
+			return IntNumber(sum), nil
+		}
+	case "-":
+		if diff := a - b; !geometry.SubOverflows(a, b, diff) {
+			return IntNumber(diff), nil
+		}
+	case "*":
+		if product, ok := geometry.CheckedMul(a, b); ok {
+			return IntNumber(product), nil
+		}
+	case "/":
+		if a == math.MinInt64 && b == -1 {
+			break
+		}
+		q, err := divide(int(a), int(b))
+		return IntNumber(int64(q)), err
+	case "%":
+		if b == 0 {
+			return Number{}, ErrDivisionByZero
+		}
+		if b == -1 {
+			return IntNumber(0), nil
+		}
+		return IntNumber(a % b), nil
+	case "^":
+		if b < 0 {
+			return floatBinary(op, float64(a), float64(b))
+		}
+		// Square-and-multiply keeps 1 ^ 1000000000 from looping forever.
+		result, base := int64(1), a
+		for ok := true; b > 0; b >>= 1 {
+			if b&1 == 1 {
+				if result, ok = geometry.CheckedMul(result, base); !ok {
+					return Number{}, ErrIntOverflow
+				}
+			}
+			if b > 1 {
+				if base, ok = geometry.CheckedMul(base, base); !ok {
+					return Number{}, ErrIntOverflow
+				}
+			}
+		}
+		return IntNumber(result), nil
+	default:
+		return Number{}, fmt.Errorf("operator tidak dikenal %q", op)
+	}
+	return Number{}, ErrIntOverflow
+}
+
+func bigBinary(op string, a, b *big.Int) (Number, error) {
+	r := new(big.Int)
+	switch op {
+	case "+":
+		r.Add(a, b)
+	case "-":
+		r.Sub(a, b)
+	case "*":
+		r.Mul(a, b)
+	case "/", "%":
+		if b.Sign() == 0 {
+			return Number{}, ErrDivisionByZero
+		}
+		// Quo/Rem truncate towards zero, like Go's / and % on ints.
+		if op == "/" {
+			r.Quo(a, b)
+		} else {
+			r.Rem(a, b)
+		}
+	case "^":
+		if b.Sign() < 0 {
+			return floatBinary(op, BigNumber(a).Float(), BigNumber(b).Float())
+		}
+		if b.BitLen() > 32 {
+			return Number{}, errors.New("eksponen terlalu besar")
+		}
+		r.Exp(a, b, nil)
+	default:
+		return Number{}, fmt.Errorf("operator tidak dikenal %q", op)
+	}
+	return BigNumber(r), nil
+}
+
+func floatBinary(op string, a, b float64) (Number, error) {
+	switch op {
+	case "+":
+		return FloatNumber(a + b), nil
+	case "-":
+		return FloatNumber(a - b), nil
+	case "*":
+		return FloatNumber(a * b), nil
+	case "/":
+		if b == 0 {
+			return Number{}, ErrDivisionByZero
+		}
+		return FloatNumber(a / b), nil
+	case "%":
+		if b == 0 {
+			return Number{}, ErrDivisionByZero
+		}
+		return FloatNumber(math.Mod(a, b)), nil
+	case "^":
+		return FloatNumber(math.Pow(a, b)), nil
+	}
+	return Number{}, fmt.Errorf("operator tidak dikenal %q", op)
+}
+
+func printCalcError(w io.Writer, indent, src string, err error) {
+	var ce *CalcError
+	if errors.As(err, &ce) {
+		for _, line := range strings.Split(ce.Caret(src), "\n") {
+			fmt.Fprintln(w, indent+line)
+		}
+	}
+	fmt.Fprintln(w, "Error:", err)
+}
+
+func runCalcREPL(c *Calculator, in io.Reader, out io.Writer) error {
+	fmt.Fprintln(out, "Kalkulator Go. Ketik ekspresi, ':vars' untuk daftar variabel, ':quit' untuk keluar.")
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			continue
+		case ":quit", ":q", "exit":
+			return nil
+		case ":vars":
+			vars := c.Vars()
+			names := make([]string, 0, len(vars))
+			for name := range vars {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(out, "  %s = %s\n", name, vars[name])
+			}
+			continue
+		}
+
+		v, err := c.Eval(line)
+		if err != nil {
+			printCalcError(out, "  ", line, err)
+			continue
+		}
+		c.Set("ans", v)
+		fmt.Fprintln(out, v)
+	}
+}
+
+func calcCommand(args []string) error {
+	fs := flag.NewFlagSet("calc", flag.ContinueOnError)
+	useBig := fs.Bool("big", false, "gunakan math/big saat integer overflow")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	c := NewCalculator()
+	c.BigInts = *useBig
+	if fs.NArg() == 0 {
+		return runCalcREPL(c, os.Stdin, os.Stdout)
+	}
+
+	src := strings.Join(fs.Args(), " ")
+	v, err := c.Eval(src)
+	if err != nil {
+		var ce *CalcError
+		if errors.As(err, &ce) {
+			fmt.Fprintln(os.Stderr, ce.Caret(src))
+		}
+		return err
+	}
+	fmt.Println(v)
+	return nil
+}
+
+func calcExample() {
+	c := NewCalculator()
+	c.Define("double", CalcFunc{Arity: 1, Fn: func(args []Number) (Number, error) {
+		n := args[0]
+		switch {
+		case !n.IsInt():
+			return Number{}, errNotInteger
+		case n.kind == numBig || n.i > math.MaxInt64/2 || n.i < math.MinInt64/2:
+			return Number{}, ErrIntOverflow
+		}
+		return IntNumber(int64(createMultiplier(2)(int(n.i)))), nil
+	}})
+
+	for _, src := range []string{
+		"1 + 2 * 3",
+		"(1 + 2) * 3",
+		"7 / 2",
+		"7.0 / 2",
+		"-2 ^ 2",
+		"2 ^ 3 ^ 2",
+		"r = 7",
+		"pi * r ^ 2",
+		"double(21)",
+		"max(3, 9.5, 4)",
+		"10 / (5 - 5)",
+		"2 ^ 63",
+		"1 + * 2",
+	} {
+		v, err := c.Eval(src)
+		if err != nil {
+			logOutput(func(w io.Writer) { printCalcError(w, "", src, err) })
+			if errors.Is(err, ErrDivisionByZero) {
+				logln("  (error yang sama dengan fungsi divide)")
+			}
+			continue
+		}
+		logf("%s = %s\n", src, v)
+	}
+
+	c.BigInts = true
+	v, _ := c.Eval("2 ^ 100")
+	logln("Dengan math/big: 2 ^ 100 =", v)
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCalcEval(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"1 + 2 * 3", "7"},
+		{"(1 + 2) * 3", "9"},
+		{"10 - 4 - 3", "3"},
+		{"100 / 10 / 5", "2"},
+		{"7 / 2", "3"},
+		{"-7 / 2", "-3"},
+		{"-7 % 3", "-1"},
+		{"7.0 / 2", "3.5"},
+		{"2 ^ 3 ^ 2", "512"}, // 2 ^ (3 ^ 2)
+		{"(2 ^ 3) ^ 2", "64"},
+		{"-2 ^ 2", "-4"}, // -(2 ^ 2)
+		{"(-2) ^ 2", "4"},
+		{"2 ^ -1", "0.5"},
+		{"--3", "3"},
+		{"-+-3", "3"},
+		{"2 * -3", "-6"},
+		{"1_000 + .5", "1000.5"},
+		{"1e3", "1000"},
+		{"max(3, 9.5, 4)", "9.5"},
+		{"min(3, -1, 4)", "-1"},
+		{"abs(-5)", "5"},
+		{"abs(-2.5)", "2.5"},
+		{"sqrt(16)", "4"},
+		{"x = y = 4", "4"},
+		{"-9223372036854775808", "-9223372036854775808"},
+		{"9223372036854775807 + 0", "9223372036854775807"},
+		{"9223372036854775808 - 1", "9223372036854775807"},
+		{"2 ^ 62", "4611686018427387904"},
+		{"1 ^ 1000000000", "1"},
+	}
+	for _, tt := range tests {
+		got, err := NewCalculator().Eval(tt.src)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("Eval(%q) = %v, %v, mau %s", tt.src, got, err, tt.want)
+		}
+	}
+}
+
+func TestCalcErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		pos  int
+		want error
+		msg  string
+	}{
+		{"1 + * 2", 4, nil, "token tidak terduga"},
+		{"1 +", 3, nil, "ekspresi tidak lengkap"},
+		{"(1 + 2", 6, nil, "diharapkan ')'"},
+		{"1 2", 2, nil, "token tidak terduga"},
+		{"3 # 4", 2, nil, "karakter tidak dikenal"},
+		{"1.2.3", 0, nil, "angka tidak valid"},
+		{"3 = 4", 2, nil, "sisi kiri '='"},
+		{"max(1 2)", 6, nil, "diharapkan ',' atau ')'"},
+		{"y + 1", 0, nil, "belum didefinisikan"},
+		{"foo(1)", 0, nil, "tidak dikenal"},
+		{"sqrt(1, 2)", 0, nil, "butuh 1 argumen, diberi 2"},
+		{"abs()", 0, nil, "butuh 1 argumen, diberi 0"},
+		{"max()", 0, nil, "minimal satu argumen"},
+		{"10 / (5 - 5)", 3, ErrDivisionByZero, ""},
+		{"10 % 0", 3, ErrDivisionByZero, ""},
+		{"1.5 / 0", 4, ErrDivisionByZero, ""},
+		{"9223372036854775807 + 1", 20, ErrIntOverflow, ""},
+		{"-9223372036854775807 - 2", 21, ErrIntOverflow, ""},
+		{"3037000500 * 3037000500", 11, ErrIntOverflow, ""},
+		{"2 ^ 63", 2, ErrIntOverflow, ""},
+		{"-(-9223372036854775808)", 0, ErrIntOverflow, ""},
+		{"-9223372036854775808 / -1", 21, ErrIntOverflow, ""},
+		{"abs(-9223372036854775808)", 0, ErrIntOverflow, ""},
+		{"9223372036854775808", 0, ErrIntOverflow, ""},
+		{"x = 9223372036854775808", 4, ErrIntOverflow, ""},
+	}
+	for _, tt := range tests {
+		_, err := NewCalculator().Eval(tt.src)
+		var ce *CalcError
+		if !errors.As(err, &ce) {
+			t.Errorf("Eval(%q) = %v, mau CalcError", tt.src, err)
+			continue
+		}
+		if ce.Pos != tt.pos {
+			t.Errorf("Eval(%q) error di kolom %d, mau %d:\n%s", tt.src, ce.Pos+1, tt.pos+1, ce.Caret(tt.src))
+		}
+		if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("Eval(%q) = %v, mau %v", tt.src, err, tt.want)
+		}
+		if !strings.Contains(err.Error(), tt.msg) {
+			t.Errorf("Eval(%q) = %v, mau berisi %q", tt.src, err, tt.msg)
+		}
+	}
+}
+
+func TestCalcBigInts(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"9223372036854775807 + 1", "9223372036854775808"},
+		{"2 ^ 100", "1267650600228229401496703205376"},
+		{"2 ^ 64 - 2 ^ 64 + 1", "1"}, // kembali menjadi int64
+		{"abs(-9223372036854775808)", "9223372036854775808"},
+		{"-9223372036854775808 / -1", "9223372036854775808"},
+		{"(2 ^ 64) / (2 ^ 62)", "4"},
+		{"-(2 ^ 70) % 7", "-2"},
+		{"2 ^ 64 * 0.5", "9.223372036854776e+18"},
+	}
+	for _, tt := range tests {
+		c := NewCalculator()
+		c.BigInts = true
+		got, err := c.Eval(tt.src)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("Eval(%q) dengan BigInts = %v, %v, mau %s", tt.src, got, err, tt.want)
+		}
+	}
+
+	c := NewCalculator()
+	c.BigInts = true
+	if got, _ := c.Eval("2 ^ 64 - 2 ^ 64 + 1"); got.kind != numInt {
+		t.Errorf("hasil big yang muat int64 masih berjenis %v", got.kind)
+	}
+	if _, err := c.Eval("(2 ^ 64) / 0"); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("pembagian big dengan nol = %v, mau ErrDivisionByZero", err)
+	}
+}
+
+func TestCalcVariables(t *testing.T) {
+	c := NewCalculator()
+	for _, src := range []string{"r = 7", "luas = pi * r ^ 2"} {
+		if _, err := c.Eval(src); err != nil {
+			t.Fatalf("Eval(%q): %v", src, err)
+		}
+	}
+	if v := c.Vars()["r"]; v.String() != "7" || !v.IsInt() {
+		t.Errorf("r = %v, mau bilangan bulat 7", v)
+	}
+	if v := c.Vars()["luas"].Float(); v < 153.93 || v > 153.94 {
+		t.Errorf("luas = %v, mau sekitar 153.94", v)
+	}
+	if _, err := c.Eval("x = 1 / 0"); err == nil {
+		t.Error("penugasan yang gagal tidak menghasilkan error")
+	}
+	if _, ok := c.Vars()["x"]; ok {
+		t.Error("penugasan yang gagal tetap menyimpan x")
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// A command is a subcommand of the lesson program, e.g. `go run . shapes
+// data/shapes.json`. Running the program without arguments still walks
+// through every lesson in order.
+type command struct {
+	Name  string
+	Usage string
+	Run   func(args []string) error
+}
+
+var commands = map[string]command{}
+
+var errUsage = errors.New("argumen tidak valid")
+
+func registerCommand(c command) {
+	if _, exists := commands[c.Name]; exists {
+		panic("perintah terdaftar dua kali: " + c.Name)
+	}
+	commands[c.Name] = c
+}
+
+func printCommandUsage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "Perintah yang tersedia:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", commands[name].Usage)
+	}
+}
+
+// parseFlags parses a command's flags. A bad flag becomes errUsage, but
+// -h stays flag.ErrHelp so that runCommand exits quietly with status 0.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return err
+	}
+	return errUsage
+}
+
+func runCommand(args []string) int {
+	c, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Perintah tidak dikenal: %s\n", args[0])
+		printCommandUsage()
+		return 2
+	}
+
+	if err := c.Run(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			// -h or -help: the flag package has printed the usage.
+			return 0
+		}
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "Penggunaan:", c.Usage)
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestRunCommandExitCodes(t *testing.T) {
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+	stderr := os.Stderr
+	os.Stderr = devNull
+	defer func() { os.Stderr = stderr }()
+
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{"shapes", "-h"}, 0},
+		{[]string{"shapes", "-help"}, 0},
+		{[]string{"query", "-h"}, 0},
+		{[]string{"shapes", "-tidak-ada"}, 2},
+		{[]string{"shapes"}, 2},
+		{[]string{"shapes", "testdata/tidak-ada.json"}, 1},
+		{[]string{"perintah-tidak-ada"}, 2},
+	}
+	for _, tt := range tests {
+		if got := runCommand(tt.args); got != tt.want {
+			t.Errorf("runCommand(%q) = %d, mau %d", tt.args, got, tt.want)
+		}
+	}
+}
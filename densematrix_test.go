@@ -0,0 +1,297 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"belajar-golang/internal/geometry"
+)
+
+func TestZeroSizedMatrices(t *testing.T) {
+	empty, err := DenseMatrixFromRows([]int{}, []int{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, c := empty.Dims(); r != 2 || c != 0 {
+		t.Fatalf("Dims = %d×%d, mau 2×0", r, c)
+	}
+
+	for _, m := range []*DenseMatrix[float64]{
+		NewDenseMatrix[float64](2, 0),
+		NewDenseMatrix[float64](0, 3),
+		NewDenseMatrix[float64](0, 0),
+		sequenceMatrix(4).Slice(1, 3, 2, 2),
+	} {
+		rows, cols := m.Dims()
+		name := fmt.Sprintf("%d×%d", rows, cols)
+		for i := range rows {
+			if len(m.Row(i)) != 0 {
+				t.Errorf("%s: Row(%d) tidak kosong", name, i)
+			}
+		}
+		for j := range cols {
+			if m.Col(j).Len() != 0 {
+				t.Errorf("%s: Col(%d) tidak kosong", name, j)
+			}
+		}
+		clone := m.Clone()
+		if !clone.Equal(m) {
+			t.Errorf("%s: Clone tidak sama dengan aslinya", name)
+		}
+		if sum, err := m.Add(clone); err != nil || !sum.Equal(m) {
+			t.Errorf("%s: Add = %v, %v", name, sum, err)
+		}
+		// (r×c)(c×r) is r×r of zeros, even when c is 0.
+		product, err := m.Mul(m.Transpose())
+		if err != nil || !product.Equal(NewDenseMatrix[float64](rows, rows)) {
+			t.Errorf("%s: M × Mᵀ = %v, %v", name, product, err)
+		}
+	}
+}
+
+func TestRowColBounds(t *testing.T) {
+	m := NewDenseMatrix[int](2, 0)
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"Row(-1)", func() { m.Row(-1) }},
+		{"Row(2)", func() { m.Row(2) }},
+		{"Col(0)", func() { m.Col(0) }},
+		{"Col(-1)", func() { NewDenseMatrix[int](0, 2).Col(-1) }},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s tidak panic", tt.name)
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
+
+func sameFloats(a, b *DenseMatrix[float64]) bool {
+	if ar, ac := a.Dims(); ar != b.rows || ac != b.cols {
+		return false
+	}
+	for i := range a.rows {
+		for j := range a.cols {
+			x, y := a.At(i, j), b.At(i, j)
+			if x != y && !(math.IsNaN(x) && math.IsNaN(y)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMulVariantsAgree(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	special, _ := DenseMatrixFromRows(
+		[]float64{0, 1, 2},
+		[]float64{inf, 0, -1},
+		[]float64{0, nan, 0},
+	)
+	zeroRow, _ := DenseMatrixFromRows([]float64{0, 1})
+	infCol, _ := DenseMatrixFromRows([]float64{inf}, []float64{1})
+	inputs := map[string][2]*DenseMatrix[float64]{
+		"0 × Inf":       {zeroRow, infCol},
+		"Inf dan NaN":   {special, special},
+		"berurutan 100": {sequenceMatrix(100), sequenceMatrix(100)},
+	}
+	for name, in := range inputs {
+		want, err := in[0].MulNaive(in[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		serial, _ := in[0].Mul(in[1])
+		parallel, _ := in[0].MulParallel(in[1], 4)
+		if !sameFloats(serial, want) {
+			t.Errorf("%s: Mul\n%v\nmau\n%v", name, serial, want)
+		}
+		if !sameFloats(parallel, want) {
+			t.Errorf("%s: MulParallel\n%v\nmau\n%v", name, parallel, want)
+		}
+	}
+	// 0 × Inf is NaN, so skipping zero entries would give 1 here.
+	if p, _ := zeroRow.Mul(infCol); !math.IsNaN(p.At(0, 0)) {
+		t.Errorf("[0 1] × [Inf 1]ᵀ = %v, mau NaN", p.At(0, 0))
+	}
+}
+
+func mustRows[T geometry.Scalar](t *testing.T, rows ...[]T) *DenseMatrix[T] {
+	t.Helper()
+	m, err := DenseMatrixFromRows(rows...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestDetBareissAndLU(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]int
+		want int
+	}{
+		{"0×0", nil, 1},
+		{"1×1", [][]int{{-7}}, -7},
+		{"3×3", [][]int{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, 49},
+		{"pivot nol", [][]int{{0, 1}, {1, 0}}, -1},
+		{"pivot nol 3×3", [][]int{{0, 2, 1}, {3, 0, 0}, {0, 0, 4}}, -24},
+		{"baris sebanding", [][]int{{1, 2}, {2, 4}}, 0},
+		{"kolom nol", [][]int{{0, 1}, {0, 2}}, 0},
+		{"segitiga 4×4", [][]int{{2, 1, 3, 4}, {0, 3, 1, 5}, {0, 0, -1, 2}, {0, 0, 0, 6}}, -36},
+	}
+	for _, tt := range tests {
+		m := NewDenseMatrix[int](len(tt.rows), len(tt.rows))
+		f := NewDenseMatrix[float64](len(tt.rows), len(tt.rows))
+		for i, row := range tt.rows {
+			for j, v := range row {
+				m.Set(i, j, v)
+				f.Set(i, j, float64(v))
+			}
+		}
+		before := m.Clone()
+		if got, err := m.Det(); err != nil || got != tt.want {
+			t.Errorf("%s: Det (Bareiss) = %d, %v, mau %d", tt.name, got, err, tt.want)
+		}
+		if !m.Equal(before) {
+			t.Errorf("%s: Det mengubah matriks aslinya", tt.name)
+		}
+		if got, err := f.Det(); err != nil || math.Abs(got-float64(tt.want)) > 1e-9 {
+			t.Errorf("%s: Det (LU) = %v, %v, mau %d", tt.name, got, err, tt.want)
+		}
+	}
+
+	// Kedua jalur harus sepakat pada matriks bilangan bulat sembarang.
+	for n := 1; n <= 6; n++ {
+		m := NewDenseMatrix[int](n, n)
+		for i := range n {
+			for j := range n {
+				m.Set(i, j, (i*7+j*3+i*j)%11-5)
+			}
+		}
+		want, _ := m.Det()
+		got, _ := toFloat64(m).Det()
+		if math.Abs(got-float64(want)) > 1e-6*max(1, math.Abs(got)) {
+			t.Errorf("%d×%d: Bareiss %d, LU %v", n, n, want, got)
+		}
+	}
+
+	if _, err := NewDenseMatrix[int](2, 3).Det(); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Det 2×3 = %v, mau ErrDimensionMismatch", err)
+	}
+}
+
+func TestDetOfSliceView(t *testing.T) {
+	m := mustRows(t,
+		[]int{9, 9, 9, 9},
+		[]int{9, 0, 2, 9},
+		[]int{9, 3, 1, 9},
+		[]int{9, 9, 9, 9},
+	)
+	view := m.Slice(1, 3, 1, 3)
+	if got, err := view.Det(); err != nil || got != -6 {
+		t.Errorf("Det potongan tengah = %d, %v, mau -6", got, err)
+	}
+	if got, _ := toFloat64(view).Det(); got != -6 {
+		t.Errorf("Det (LU) potongan tengah = %v, mau -6", got)
+	}
+	if m.At(1, 1) != 0 || m.At(2, 1) != 3 {
+		t.Error("Det pada potongan mengubah matriks induknya")
+	}
+}
+
+func TestInverse(t *testing.T) {
+	a := mustRows(t, []float64{4, 7}, []float64{2, 6})
+	want := mustRows(t, []float64{0.6, -0.7}, []float64{-0.2, 0.4})
+	inv, err := Inverse(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range 2 {
+		for j := range 2 {
+			if math.Abs(inv.At(i, j)-want.At(i, j)) > 1e-12 {
+				t.Errorf("Inverse\n%vmau\n%v", inv, want)
+			}
+		}
+	}
+
+	// A × A⁻¹ = I, juga untuk potongan yang butuh pertukaran baris.
+	dominant := sequenceMatrix(6)
+	for i := range 6 {
+		dominant.Set(i, i, dominant.At(i, i)+20)
+	}
+	for _, m := range []*DenseMatrix[float64]{
+		mustRows(t, []float64{0, 1, 2}, []float64{1, 0, 3}, []float64{4, -3, 8}),
+		dominant.Slice(1, 5, 1, 5),
+	} {
+		inv, err := Inverse(m)
+		if err != nil {
+			t.Fatalf("Inverse\n%v: %v", m, err)
+		}
+		product, _ := m.Mul(inv)
+		n, _ := m.Dims()
+		for i := range n {
+			for j := range n {
+				want := 0.0
+				if i == j {
+					want = 1
+				}
+				if math.Abs(product.At(i, j)-want) > 1e-9 {
+					t.Fatalf("A × A⁻¹ tidak identitas:\n%v", product)
+				}
+			}
+		}
+	}
+
+	inv32, err := Inverse(mustRows(t, []float32{2, 0}, []float32{0, 4}))
+	if err != nil || inv32.At(0, 0) != 0.5 || inv32.At(1, 1) != 0.25 {
+		t.Errorf("Inverse float32 = %v, %v", inv32, err)
+	}
+
+	singular := []*DenseMatrix[float64]{
+		mustRows(t, []float64{1, 2}, []float64{2, 4}),
+		mustRows(t, []float64{1, 2, 3}, []float64{4, 5, 6}, []float64{7, 8, 9}),
+		mustRows(t, []float64{1e6, 1}, []float64{1e6, 1 + 1e-12}),
+		NewDenseMatrix[float64](3, 3),
+	}
+	for _, m := range singular {
+		if inv, err := Inverse(m); !errors.Is(err, ErrSingularMatrix) {
+			t.Errorf("Inverse\n%v= %v, %v, mau ErrSingularMatrix", m, inv, err)
+		}
+	}
+	if _, err := Inverse(NewDenseMatrix[float64](2, 3)); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Inverse 2×3 = %v, mau ErrDimensionMismatch", err)
+	}
+}
+
+var benchSizes = []int{64, 128, 256}
+
+func benchmarkMul(b *testing.B, mul func(a, b *DenseMatrix[float64]) (*DenseMatrix[float64], error)) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			x, y := sequenceMatrix(n), sequenceMatrix(n)
+			for b.Loop() {
+				if _, err := mul(x, y); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMulNaive(b *testing.B) {
+	benchmarkMul(b, (*DenseMatrix[float64]).MulNaive)
+}
+
+func BenchmarkMulParallel(b *testing.B) {
+	benchmarkMul(b, func(x, y *DenseMatrix[float64]) (*DenseMatrix[float64], error) {
+		return x.MulParallel(y, 0)
+	})
+}
@@ -0,0 +1,51 @@
+package main
+
+import "belajar-golang/internal/dump"
+
+type dumpNode struct {
+	Name     string
+	Next     *dumpNode
+	Children []*dumpNode
+}
+
+func dumpExample() {
+	m := Manager{
+		Person: Person{FirstName: "Siti", LastName: "Rahma", Age: 41, isMarried: true},
+		Contact: Contact{
+			Email:       "siti@example.com",
+			Phone:       "0812-0000-1111",
+			HomeAddress: Address{Street: "Jl. Merdeka 1", City: "Bandung", ZipCode: "40111"},
+		},
+		Department: "Teknologi",
+		Level:      3,
+	}
+	opts := dump.Options{HideAddresses: true}
+	logf("%s", dump.Sdump(m, opts))
+
+	logf("%s", dump.Sdump(map[string]any{
+		"titik":   Point{X: 1, Y: 2},
+		"skor":    map[int]string{3: "c", 1: "a", 2: "b"},
+		"kosong":  []int(nil),
+		"byte":    []byte("Go"),
+		"fungsi":  createMultiplier(2),
+		"channel": make(chan int, 4),
+		"error":   ErrDivisionByZero,
+	}, opts))
+
+	// A cycle: a → b → a. Without detection this would print forever.
+	a := &dumpNode{Name: "a"}
+	b := &dumpNode{Name: "b", Next: a}
+	a.Next = b
+	a.Children = []*dumpNode{b}
+	logf("%s", dump.Sdump(a, opts))
+
+	// Maps and slices can contain themselves too.
+	self := map[string]any{"nama": "diri"}
+	self["diri"] = self
+	list := []any{"awal", nil}
+	list[1] = list
+	logf("%s", dump.Sdump(self, opts))
+	logf("%s", dump.Sdump(list, opts))
+
+	logf("Dengan MaxDepth 1: %s", dump.Sdump(m, dump.Options{MaxDepth: 1}))
+}
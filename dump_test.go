@@ -0,0 +1,23 @@
+package main
+
+import (
+	"testing"
+
+	"belajar-golang/internal/dump"
+)
+
+func TestDumpManagerGolden(t *testing.T) {
+	m := Manager{
+		Person: Person{FirstName: "Siti", LastName: "Rahma", Age: 41, isMarried: true},
+		Contact: Contact{
+			Email:       "siti@example.com",
+			Phone:       "0812-0000-1111",
+			HomeAddress: Address{Street: "Jl. Merdeka 1", City: "Bandung", ZipCode: "40111"},
+		},
+		Department: "Teknologi",
+		Level:      3,
+	}
+	opts := dump.Options{HideAddresses: true}
+	checkGolden(t, "dump_manager.golden", dump.Sdump(m, opts))
+	checkGolden(t, "dump_manager_ptr.golden", dump.Sdump(&m, opts))
+}
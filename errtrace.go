@@ -0,0 +1,305 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const maxTraceDepth = 32
+
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+func (f Frame) String() string {
+	return fmt.Sprintf("%s\n\t%s:%d", f.Function, f.File, f.Line)
+}
+
+// Site is the frame as file:line without the directory.
+func (f Frame) Site() string {
+	return fmt.Sprintf("%s:%d", filepath.Base(f.File), f.Line)
+}
+
+type TracedError struct {
+	msg    string
+	err    error
+	frames []Frame
+}
+
+type tracedJoinError struct {
+	msg    string
+	errs   []error
+	frames []Frame
+}
+
+func callers(skip int) []Frame {
+	pcs := make([]uintptr, maxTraceDepth)
+	n := runtime.Callers(skip+2, pcs)
+	iter := runtime.CallersFrames(pcs[:n])
+
+	var frames []Frame
+	for {
+		f, more := iter.Next()
+		if f.Function == "runtime.main" || f.Function == "runtime.goexit" {
+			break
+		}
+		frames = append(frames, Frame{Function: f.Function, File: f.File, Line: f.Line})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// wrapFrames records the stack of its caller's caller like callers, but
+// drops the outer frames that the stack of a cause already holds. A wrap
+// then shows only where it happened, and %+v prints each frame once.
+func wrapFrames(skip int, causes ...error) []Frame {
+	frames := callers(skip + 1)
+	keep := len(frames)
+	for _, cause := range causes {
+		inner := causeFrames(cause)
+		n := 0
+		// Keep at least the wrap site itself.
+		for n < len(frames)-1 && n < len(inner) && frames[len(frames)-1-n] == inner[len(inner)-1-n] {
+			n++
+		}
+		keep = min(keep, len(frames)-n)
+	}
+	return frames[:keep]
+}
+
+// causeFrames returns the frames of the outermost error in err's tree
+// that recorded any.
+func causeFrames(err error) []Frame {
+	if frames := errorFrames(err); len(frames) > 0 {
+		return frames
+	}
+	for _, cause := range errorCauses(err) {
+		if frames := causeFrames(cause); len(frames) > 0 {
+			return frames
+		}
+	}
+	return nil
+}
+
+func NewTraced(msg string) error {
+	return &TracedError{msg: msg, frames: callers(1)}
+}
+
+func Tracef(format string, args ...interface{}) error {
+	return &TracedError{msg: fmt.Sprintf(format, args...), frames: callers(1)}
+}
+
+func Trace(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return &TracedError{msg: msg, err: err, frames: wrapFrames(1, err)}
+}
+
+func TraceJoin(msg string, errs ...error) error {
+	var nonNil []error
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+	if len(nonNil) == 0 {
+		return nil
+	}
+	return &tracedJoinError{msg: msg, errs: nonNil, frames: wrapFrames(1, nonNil...)}
+}
+
+func (e *TracedError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+	if e.msg == "" {
+		return e.err.Error()
+	}
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *TracedError) Unwrap() error {
+	return e.err
+}
+
+func (e *TracedError) Frames() []Frame {
+	return e.frames
+}
+
+func (e *TracedError) Format(s fmt.State, verb rune) {
+	formatTraced(e, s, verb)
+}
+
+func (e *TracedError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorJSON(e))
+}
+
+func (e *tracedJoinError) Error() string {
+	parts := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		parts[i] = err.Error()
+	}
+	joined := strings.Join(parts, "; ")
+	if e.msg == "" {
+		return joined
+	}
+	return e.msg + ": " + joined
+}
+
+func (e *tracedJoinError) Unwrap() []error {
+	return e.errs
+}
+
+func (e *tracedJoinError) Frames() []Frame {
+	return e.frames
+}
+
+func (e *tracedJoinError) Format(s fmt.State, verb rune) {
+	formatTraced(e, s, verb)
+}
+
+func (e *tracedJoinError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorJSON(e))
+}
+
+func formatTraced(err error, s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			writeErrorTree(s, err, "", "")
+			return
+		}
+		io.WriteString(s, err.Error())
+	case 's':
+		io.WriteString(s, err.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", err.Error())
+	default:
+		// The form fmt itself uses for a verb the operand does not support.
+		fmt.Fprintf(s, "%%!%c(%T=%s)", verb, err, err.Error())
+	}
+}
+
+// layerMessage returns only the text an error adds on top of its causes,
+// so the tree does not repeat the whole chain on every line.
+func layerMessage(err error) string {
+	switch e := err.(type) {
+	case *TracedError:
+		if e.msg == "" && e.err != nil {
+			return "(trace)"
+		}
+		return e.msg
+	case *tracedJoinError:
+		if e.msg == "" {
+			return "(join)"
+		}
+		return e.msg
+	}
+
+	msg := err.Error()
+	for _, cause := range errorCauses(err) {
+		msg = strings.TrimSuffix(msg, ": "+cause.Error())
+	}
+	return msg
+}
+
+func errorCauses(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		return e.Unwrap()
+	case interface{ Unwrap() error }:
+		if cause := e.Unwrap(); cause != nil {
+			return []error{cause}
+		}
+	}
+	return nil
+}
+
+func errorFrames(err error) []Frame {
+	if f, ok := err.(interface{ Frames() []Frame }); ok {
+		return f.Frames()
+	}
+	return nil
+}
+
+func writeErrorTree(w io.Writer, err error, prefix, childPrefix string) {
+	fmt.Fprintf(w, "%s%s\n", prefix, layerMessage(err))
+	for _, f := range errorFrames(err) {
+		fmt.Fprintf(w, "%s    %s\n%s        %s:%d\n", childPrefix, f.Function, childPrefix, f.File, f.Line)
+	}
+
+	causes := errorCauses(err)
+	for i, cause := range causes {
+		if i == len(causes)-1 {
+			writeErrorTree(w, cause, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			writeErrorTree(w, cause, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+type errorNode struct {
+	Message string       `json:"message"`
+	Type    string       `json:"type"`
+	Frames  []Frame      `json:"frames,omitempty"`
+	Causes  []*errorNode `json:"causes,omitempty"`
+}
+
+func errorJSON(err error) *errorNode {
+	node := &errorNode{
+		Message: layerMessage(err),
+		Type:    fmt.Sprintf("%T", err),
+		Frames:  errorFrames(err),
+	}
+	for _, cause := range errorCauses(err) {
+		node.Causes = append(node.Causes, errorJSON(cause))
+	}
+	return node
+}
+
+func MarshalErrorJSON(err error) ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+	return json.MarshalIndent(errorJSON(err), "", "  ")
+}
+
+func errorTraceExample() {
+	err := loadConfigSimplified("config.yaml", true)
+
+	logf("Format biasa (%%v):\n")
+	logf("  %v\n", err)
+
+	logf("\nFormat %%+v (pohon error beserta lokasi pembuatannya):\n")
+	logf("%+v", err)
+
+	var configErr *ConfigError
+	logln("\nerrors.As ke *ConfigError:", errors.As(err, &configErr))
+
+	joined := TraceJoin("validasi gagal",
+		validateInput(""),
+		loadConfigSimplified("non_existent_config.yaml", false),
+	)
+	logln("\nMulti-error (Unwrap() []error):")
+	logf("%+v", joined)
+	logln("errors.Is(joined, os.ErrNotExist):", errors.Is(joined, os.ErrNotExist))
+
+	data, jsonErr := MarshalErrorJSON(err)
+	if jsonErr != nil {
+		logln("Gagal membuat JSON:", jsonErr)
+		return
+	}
+	logln("\nJSON untuk log:")
+	logln(string(data))
+}
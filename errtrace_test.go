@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+)
+
+// traceTwice wraps a traced error in the same function, so both layers
+// share every frame above it.
+func traceTwice() error {
+	inner := NewTraced("dalam")
+	return Trace(inner, "luar")
+}
+
+func TestTraceIsAsUnwrap(t *testing.T) {
+	if Trace(nil, "x") != nil || TraceJoin("x", nil, nil) != nil {
+		t.Error("Trace/TraceJoin dari nil tidak nil")
+	}
+
+	err := Trace(os.ErrNotExist, "gagal")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Error("errors.Is tidak menembus TracedError")
+	}
+	if errors.Unwrap(err) != os.ErrNotExist {
+		t.Errorf("Unwrap = %v, mau os.ErrNotExist", errors.Unwrap(err))
+	}
+	if got := err.Error(); got != "gagal: file does not exist" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	err = loadConfigSimplified("config.yaml", true)
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) || configErr.Field != "database_url" {
+		t.Errorf("errors.As ke *ConfigError gagal: %v", err)
+	}
+	var traced *TracedError
+	if !errors.As(err, &traced) || traced.Unwrap() != configErr {
+		t.Errorf("lapisan luar bukan TracedError yang membungkus ConfigError: %v", err)
+	}
+}
+
+func TestTraceJoinUnwrapsAll(t *testing.T) {
+	first := NewTraced("pertama")
+	err := TraceJoin("gabungan", first, nil, os.ErrPermission)
+	errs := err.(interface{ Unwrap() []error }).Unwrap()
+	if len(errs) != 2 || errs[0] != first || errs[1] != os.ErrPermission {
+		t.Errorf("Unwrap() = %v, mau [pertama, ErrPermission] tanpa nil", errs)
+	}
+	if !errors.Is(err, os.ErrPermission) || !errors.Is(err, first) {
+		t.Error("errors.Is tidak menembus semua cabang")
+	}
+	if errors.Unwrap(err) != nil {
+		t.Error("errors.Unwrap pada multi-error harus nil")
+	}
+	if got := err.Error(); got != "gabungan: pertama; permission denied" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestTracedFormatVerbs(t *testing.T) {
+	err := Trace(os.ErrNotExist, "gagal")
+	tests := []struct{ format, want string }{
+		{"%v", "gagal: file does not exist"},
+		{"%s", "gagal: file does not exist"},
+		{"%q", `"gagal: file does not exist"`},
+		{"%d", "%!d(*main.TracedError=gagal: file does not exist)"},
+		{"%x", "%!x(*main.TracedError=gagal: file does not exist)"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, err); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, mau %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestTracedTreeLayout(t *testing.T) {
+	out := fmt.Sprintf("%+v", traceTwice())
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) < 6 {
+		t.Fatalf("pohon terlalu pendek:\n%s", out)
+	}
+	// The outer layer keeps only its wrap site; the shared frames are
+	// printed once, under the inner layer.
+	// Function names start with the import path in a test binary and
+	// with "main." in the program, so only their suffix is compared.
+	if lines[0] != "luar" || !strings.HasSuffix(lines[1], ".traceTwice") {
+		t.Errorf("lapisan luar:\n%s", strings.Join(lines[:2], "\n"))
+	}
+	if !strings.HasPrefix(lines[2], "        ") || !strings.Contains(lines[2], "errtrace_test.go:") {
+		t.Errorf("baris 2 = %q, mau lokasi di errtrace_test.go", lines[2])
+	}
+	if lines[3] != "└── dalam" || !strings.HasSuffix(lines[4], ".traceTwice") || !strings.Contains(lines[6], ".TestTracedTreeLayout") {
+		t.Errorf("lapisan dalam:\n%s", strings.Join(lines[3:7], "\n"))
+	}
+	if n := strings.Count(out, ".TestTracedTreeLayout\n"); n != 1 {
+		t.Errorf("frame pemanggil dicetak %d kali, mau 1:\n%s", n, out)
+	}
+
+	joined := fmt.Sprintf("%+v", TraceJoin("validasi", errors.New("a"), errors.New("b")))
+	for _, w := range []string{"validasi\n", "├── a\n", "└── b\n"} {
+		if !strings.Contains(joined, w) {
+			t.Errorf("multi-error tanpa %q:\n%s", w, joined)
+		}
+	}
+}
+
+func TestMarshalErrorJSON(t *testing.T) {
+	data, err := MarshalErrorJSON(traceTwice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var node errorNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		t.Fatal(err)
+	}
+	if node.Message != "luar" || node.Type != "*main.TracedError" || len(node.Frames) != 1 {
+		t.Errorf("lapisan luar = %q %s dengan %d frame, mau \"luar\" dengan 1", node.Message, node.Type, len(node.Frames))
+	}
+	if len(node.Causes) != 1 || node.Causes[0].Message != "dalam" || len(node.Causes[0].Frames) < 2 {
+		t.Errorf("cause = %+v", node.Causes)
+	}
+	if data, _ := MarshalErrorJSON(nil); string(data) != "null" {
+		t.Errorf("MarshalErrorJSON(nil) = %s", data)
+	}
+}
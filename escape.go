@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The compiler explains its escape analysis when built with
+// -gcflags=-m: one line per decision, such as
+//
+//	./main.go:738:2: moved to heap: x
+//	./main.go:740:13: ... argument does not escape
+//
+// With -m=2 each escape is also preceded by the data flow that caused it:
+//
+//	./main.go:738:2: x escapes to heap in pointerBasics:
+//	./main.go:738:2:   flow: {heap} ← &x:
+//	./main.go:738:2:     from &x (address-of) at ./main.go:741:20
+//
+// This file runs that build and turns the lines back into data that can
+// be matched to the functions of a lesson.
+
+type EscapeDiagnostic struct {
+	File    string
+	Line    int
+	Col     int
+	Message string
+	Func    string   // enclosing function, filled in by AttachFuncs
+	Why     []string // data flow behind the decision, -m=2 only
+}
+
+var escapeLineRE = regexp.MustCompile(`^(.+?\.go):(\d+):(\d+): (.*)$`)
+
+func (d EscapeDiagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s", filepath.Base(d.File), d.Line, d.Col, d.Message)
+}
+
+// Escapes reports whether the diagnostic puts something on the heap.
+func (d EscapeDiagnostic) Escapes() bool {
+	return strings.HasPrefix(d.Message, "moved to heap:") || strings.HasSuffix(d.Message, "escapes to heap")
+}
+
+// Kind sorts heap escapes by what ended up on the heap: a variable
+// ("variabel"), a closure ("closure"), a freshly built value such as
+// &T{...}, new(T), make or a string concatenation ("alokasi"), or an
+// existing value copied into an interface, usually an argument of fmt
+// ("interface"). Diagnostics that are not escapes have no kind.
+func (d EscapeDiagnostic) Kind() string {
+	if !d.Escapes() {
+		return ""
+	}
+	if strings.HasPrefix(d.Message, "moved to heap:") {
+		return "variabel"
+	}
+	subject := strings.TrimSuffix(d.Message, " escapes to heap")
+	switch {
+	case strings.HasPrefix(subject, "func literal"):
+		return "closure"
+	case strings.HasPrefix(subject, "&"), strings.HasPrefix(subject, "new("), strings.HasPrefix(subject, "make("),
+		strings.HasPrefix(subject, "append("), strings.HasPrefix(subject, "string("),
+		strings.Contains(subject, "{...}"), strings.Contains(subject, " + ") && !strings.HasPrefix(subject, `"`):
+		return "alokasi"
+	}
+	return "interface"
+}
+
+// ParseEscapeOutput reads -m or -m=2 output. The explanation blocks of
+// -m=2 are not returned as diagnostics of their own; their indented flow
+// lines end up in Why of the decision at the same position.
+func ParseEscapeOutput(r io.Reader) ([]EscapeDiagnostic, error) {
+	var diags []EscapeDiagnostic
+	why := map[string][]string{}
+	var block string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		m := escapeLineRE.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue // "# command-line-arguments" and similar headers
+		}
+		pos := m[1] + ":" + m[2] + ":" + m[3]
+		switch {
+		case strings.HasPrefix(m[4], " "):
+			if block == pos {
+				why[pos] = append(why[pos], strings.TrimSpace(m[4]))
+			}
+			continue
+		case strings.HasSuffix(m[4], ":"):
+			block = pos
+			continue
+		}
+		block = ""
+		line, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		diags = append(diags, EscapeDiagnostic{File: m[1], Line: line, Col: col, Message: m[4]})
+	}
+	for i, d := range diags {
+		if d.Escapes() {
+			diags[i].Why = why[fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Col)]
+		}
+	}
+	return diags, scanner.Err()
+}
+
+// RunEscapeAnalysis builds the Go files in dir with -gcflags=-m (or -m=2
+// for level 2) and returns the compiler's diagnostics. The binary itself
+// is thrown away.
+func RunEscapeAnalysis(dir string, level int) ([]EscapeDiagnostic, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil || len(files) == 0 {
+		return nil, fmt.Errorf("tidak ada file Go di %q", dir)
+	}
+	for i, f := range files {
+		files[i] = filepath.Base(f)
+	}
+
+	gcflags := "-gcflags=-m"
+	if level > 1 {
+		gcflags = fmt.Sprintf("-gcflags=-m=%d", level)
+	}
+	args := append([]string{"build", gcflags, "-o", os.DevNull}, files...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("go build gagal: %s", strings.TrimSpace(stderr.String()))
+		}
+		return nil, fmt.Errorf("gagal menjalankan go build: %w", err)
+	}
+
+	diags, err := ParseEscapeOutput(&stderr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range diags {
+		if !filepath.IsAbs(diags[i].File) {
+			diags[i].File = filepath.Join(dir, diags[i].File)
+		}
+	}
+	return diags, nil
+}
+
+type funcRange struct {
+	name       string
+	start, end int
+	calls      []string // package-level functions called by name
+}
+
+// AttachFuncs fills in Func for every diagnostic by parsing the source
+// files and finding the function whose body contains the line.
+func AttachFuncs(diags []EscapeDiagnostic) error {
+	ranges := map[string][]funcRange{}
+	for i, d := range diags {
+		if _, done := ranges[d.File]; !done {
+			r, err := parseFuncRanges(d.File)
+			if err != nil {
+				return err
+			}
+			ranges[d.File] = r
+		}
+		for _, fr := range ranges[d.File] {
+			if d.Line >= fr.start && d.Line <= fr.end {
+				diags[i].Func = fr.name
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func parseFuncRanges(path string) ([]funcRange, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca %s: %w", path, err)
+	}
+	var ranges []funcRange
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		name := fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			name = receiverName(fn.Recv.List[0].Type) + "." + name
+		}
+		ranges = append(ranges, funcRange{
+			name:  name,
+			start: fset.Position(fn.Pos()).Line,
+			end:   fset.Position(fn.End()).Line,
+			calls: calledFuncs(fn),
+		})
+	}
+	return ranges, nil
+}
+
+// calledFuncs lists the plain identifiers called in fn, in order of first
+// call. Method calls and calls through selectors such as fmt.Println are
+// skipped; the identifiers left are functions, closures in variables and
+// conversions, and the callers sort out which of them are functions.
+func calledFuncs(fn *ast.FuncDecl) []string {
+	if fn.Body == nil {
+		return nil
+	}
+	var calls []string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fun := call.Fun
+		if idx, ok := fun.(*ast.IndexExpr); ok {
+			fun = idx.X // generic instantiation such as SliceGrowth[int]
+		}
+		if id, ok := fun.(*ast.Ident); ok && !slices.Contains(calls, id.Name) {
+			calls = append(calls, id.Name)
+		}
+		return true
+	})
+	return calls
+}
+
+// CallGraph maps every top-level function in dir to the top-level
+// functions of the same directory that it calls directly.
+func CallGraph(dir string) (map[string][]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	all := map[string]funcRange{}
+	for _, f := range files {
+		ranges, err := parseFuncRanges(f)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range ranges {
+			all[r.name] = r
+		}
+	}
+	graph := map[string][]string{}
+	for name, r := range all {
+		for _, c := range r.calls {
+			if _, ok := all[c]; ok {
+				graph[name] = append(graph[name], c)
+			}
+		}
+	}
+	return graph, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return "(*" + receiverName(t.X) + ")"
+	case *ast.IndexExpr:
+		return receiverName(t.X)
+	case *ast.IndexListExpr:
+		return receiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return "?"
+}
+
+// EscapesByFunc keeps the diagnostics of the named functions, sorted by
+// position. Inlining notes are dropped: they are about calls, not about
+// where values live.
+func EscapesByFunc(diags []EscapeDiagnostic, funcs ...string) map[string][]EscapeDiagnostic {
+	out := map[string][]EscapeDiagnostic{}
+	for _, d := range diags {
+		if !slices.Contains(funcs, d.Func) || strings.HasPrefix(d.Message, "inlining call") ||
+			strings.HasPrefix(d.Message, "can inline") {
+			continue
+		}
+		out[d.Func] = append(out[d.Func], d)
+	}
+	for _, list := range out {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Line != list[j].Line {
+				return list[i].Line < list[j].Line
+			}
+			return list[i].Col < list[j].Col
+		})
+	}
+	return out
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"belajar-golang/internal/generics"
+)
+
+// sumNumbers only adds ints and describe takes interface{}, which loses
+// the type the moment a value goes in. Type parameters remove both
+// limits: the functions and containers in internal/generics are written
+// once, work for every element type that satisfies their constraint, and
+// hand values back with their static type intact.
+
+func genericsFuncExample() {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+
+	// The loop every earlier lesson writes by hand ...
+	var squaresLoop []int
+	for _, n := range numbers {
+		if n%2 == 0 {
+			squaresLoop = append(squaresLoop, n*n)
+		}
+	}
+	// ... and the same thing from reusable pieces.
+	even := generics.Filter(numbers, func(n int) bool {
+		return n%2 == 0
+	})
+	squares := generics.Map(even, func(n int) int {
+		return n * n
+	})
+	logln("Kuadrat bilangan genap:", squares, "sama dengan versi loop:", slices.Equal(squares, squaresLoop))
+
+	labels := generics.Map(numbers, func(n int) string {
+		return fmt.Sprintf("#%d", n)
+	})
+	logf("Map bisa mengganti tipe: %q (%T)\n", labels, labels)
+
+	longest := generics.Reduce(strings.Fields("Go itu sederhana dan menyenangkan"), "", func(acc, w string) string {
+		if len(w) > len(acc) {
+			return w
+		}
+		return acc
+	})
+	logln("Kata terpanjang (Reduce):", longest)
+}
+
+func genericsNumberExample() {
+	logln("sumNumbers (hanya int):", sumNumbers("int", 1, 2, 3))
+	logln("Sum[int]:", generics.Sum(1, 2, 3))
+	logln("Sum[float64]:", generics.Sum(1.5, 2.25, 3.0))
+	logln("Sum[ByteSize]:", generics.Sum(ByteSize(512*KB), ByteSize(1536*KB)))
+
+	largest, ok := generics.Max(3, 9, 4)
+	logln("Max:", largest, ok)
+	_, ok = generics.Max[float64]()
+	logln("Max tanpa nilai, ok:", ok)
+
+	// describe takes interface{}: the int goes in but comes back only
+	// after a type assertion. A type parameter keeps the static type.
+	var boxed interface{} = generics.Sum(2, 3)
+	if n, isInt := boxed.(int); isInt {
+		logln("Dari interface{} perlu type assertion:", n+1)
+	}
+	logln("Dari Sum langsung bertipe int:", generics.Sum(2, 3)+1)
+}
+
+func genericsCollectionsExample() {
+	a := generics.NewSet("Jakarta", "Bandung", "Surabaya")
+	b := generics.NewSet("Bandung", "Medan")
+	logln("Gabungan:", generics.SortedItems(a.Union(b)))
+	logln("Irisan:", generics.SortedItems(a.Intersect(b)))
+	logln("Selisih a-b:", generics.SortedItems(a.Difference(b)))
+	logln("Ada Medan di a?", a.Has("Medan"))
+
+	// mapExample's populations map, but in insertion order.
+	populations := generics.NewOrderedMap[string, int]()
+	populations.Set("Surabaya", 3_000_000)
+	populations.Set("Jakarta", 10_000_000)
+	populations.Set("Bandung", 2_500_000)
+	populations.Set("Surabaya", 2_900_000)
+	logln("map biasa (dicetak urut kunci oleh fmt):", map[string]int{"Surabaya": 2_900_000, "Jakarta": 10_000_000, "Bandung": 2_500_000})
+	logln("OrderedMap (urutan sisip):", populations)
+	populations.Delete("Jakarta")
+	logln("Setelah Delete(Jakarta):", populations.Keys(), generics.Sum(populations.Values()...))
+
+	var stack generics.Stack[string]
+	for _, w := range []string{"satu", "dua", "tiga"} {
+		stack.Push(w)
+	}
+	var popped []string
+	for stack.Len() > 0 {
+		w, _ := stack.Pop()
+		popped = append(popped, w)
+	}
+	logln("Stack (LIFO):", popped)
+
+	var queue generics.Queue[int]
+	for i := range 5 {
+		queue.Enqueue(i + 1)
+	}
+	first, _ := queue.Dequeue()
+	second, _ := queue.Dequeue()
+	logln("Queue (FIFO):", first, second, "sisa", queue.Len())
+
+	type task struct {
+		name     string
+		priority int
+	}
+	pq := generics.NewPriorityQueue(func(a, b task) bool {
+		return a.priority > b.priority
+	})
+	pq.Push(task{"tulis dokumentasi", 1})
+	pq.Push(task{"perbaiki bug produksi", 5})
+	pq.Push(task{"review PR", 3})
+	for pq.Len() > 0 {
+		t, _ := pq.Pop()
+		logf("  prioritas %d: %s\n", t.priority, t.name)
+	}
+}
+
+func genericsResultOptionExample() {
+	// divide returns (int, error); Result keeps both in one value.
+	results := []generics.Result[int]{generics.Try(divide(10, 2)), generics.Try(divide(1, 0))}
+	for _, r := range results {
+		logln("Try(divide):", r, "OrElse(-1) =", r.OrElse(-1))
+	}
+	halved := generics.Then(results[0], func(n int) (int, error) {
+		return divide(n, 2)
+	})
+	logln("Then(divide 2):", halved)
+	failed := generics.Then(results[1], func(n int) (int, error) {
+		return divide(n, 2)
+	})
+	_, err := failed.Get()
+	logln("Error ikut diteruskan:", errors.Is(err, ErrDivisionByZero))
+
+	// pointerForOptionalConfig's Timeout *int, as an Option.
+	type config struct {
+		Timeout generics.Option[int]
+		Retries int
+	}
+	cfg1 := config{Timeout: generics.Some(30), Retries: 3}
+	cfg2 := config{Retries: 5}
+	for i, cfg := range []config{cfg1, cfg2} {
+		logf("Cfg%d Timeout: %v, dipakai: %d\n", i+1, cfg.Timeout, cfg.Timeout.OrElse(60))
+	}
+}
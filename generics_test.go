@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"belajar-golang/internal/generics"
+)
+
+// The tests below check each generic type against the non-generic code
+// it replaces in the earlier lessons.
+
+func TestSumMatchesSumNumbers(t *testing.T) {
+	f := func(values []int) bool {
+		var want int
+		discardStdout(func() { want = sumNumbers("quick", values...) })
+		return generics.Sum(values...) == want
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if got := generics.Sum(ByteSize(512*KB), ByteSize(512*KB)); got != ByteSize(MB) {
+		t.Errorf("Sum(ByteSize) = %v, mau 1 MB", got)
+	}
+}
+
+func TestMapFilterMatchLoop(t *testing.T) {
+	f := func(numbers []int) bool {
+		var want []int
+		for _, n := range numbers {
+			if n%2 == 0 {
+				want = append(want, n*n)
+			}
+		}
+		even := generics.Filter(numbers, func(n int) bool { return n%2 == 0 })
+		got := generics.Map(even, func(n int) int { return n * n })
+		return slices.Equal(got, want)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetMatchesMap(t *testing.T) {
+	f := func(a, b []uint8) bool {
+		// The map[T]struct{} idiom, written out by hand.
+		inA := map[uint8]struct{}{}
+		for _, v := range a {
+			inA[v] = struct{}{}
+		}
+		var want []uint8
+		for _, v := range b {
+			if _, ok := inA[v]; ok && !slices.Contains(want, v) {
+				want = append(want, v)
+			}
+		}
+		slices.Sort(want)
+		got := generics.SortedItems(generics.NewSet(a...).Intersect(generics.NewSet(b...)))
+		return slices.Equal(got, want) && generics.NewSet(a...).Len() == len(inA)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOrderedMapMatchesMap(t *testing.T) {
+	// mapExample's populations, set in a fixed order with one update.
+	populations := map[string]int{}
+	om := generics.NewOrderedMap[string, int]()
+	for _, kv := range []struct {
+		city string
+		pop  int
+	}{{"Surabaya", 3_000_000}, {"Jakarta", 10_000_000}, {"Bandung", 2_500_000}, {"Surabaya", 2_900_000}} {
+		populations[kv.city] = kv.pop
+		om.Set(kv.city, kv.pop)
+	}
+	delete(populations, "Jakarta")
+	om.Delete("Jakarta")
+
+	if om.Len() != len(populations) {
+		t.Fatalf("Len = %d, mau %d", om.Len(), len(populations))
+	}
+	for city, pop := range populations {
+		if got, ok := om.Get(city); !ok || got != pop {
+			t.Errorf("Get(%q) = %d, %v; mau %d", city, got, ok, pop)
+		}
+	}
+	if got := om.Keys(); !slices.Equal(got, []string{"Surabaya", "Bandung"}) {
+		t.Errorf("Keys = %v, mau urutan sisip [Surabaya Bandung]", got)
+	}
+	if want := slices.Sorted(maps.Keys(populations)); !slices.Equal(slices.Sorted(slices.Values(om.Keys())), want) {
+		t.Errorf("kunci %v, mau %v", om.Keys(), want)
+	}
+}
+
+func TestStackMatchesSlice(t *testing.T) {
+	f := func(ops []int8) bool {
+		var s generics.Stack[int8]
+		var slice []int8 // push with append, pop from the end
+		for _, op := range ops {
+			if op >= 0 {
+				s.Push(op)
+				slice = append(slice, op)
+				continue
+			}
+			got, ok := s.Pop()
+			if ok != (len(slice) > 0) {
+				return false
+			}
+			if ok {
+				if got != slice[len(slice)-1] {
+					return false
+				}
+				slice = slice[:len(slice)-1]
+			}
+		}
+		return s.Len() == len(slice)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOptionMatchesPointer(t *testing.T) {
+	// pointerForOptionalConfig's Config.Timeout is a *int where nil means
+	// "use the default".
+	timeout := func(cfg Config) int {
+		if cfg.Timeout != nil {
+			return *cfg.Timeout
+		}
+		return 60
+	}
+	zero, thirty := 0, 30
+	for _, p := range []*int{nil, &zero, &thirty} {
+		opt := generics.None[int]()
+		if p != nil {
+			opt = generics.Some(*p)
+		}
+		want := timeout(Config{Timeout: p})
+		if got := opt.OrElse(60); got != want {
+			t.Errorf("Option %v: OrElse = %d, mau %d", opt, got, want)
+		}
+		if opt.IsSome() != (p != nil) {
+			t.Errorf("Option %v: IsSome = %v", opt, opt.IsSome())
+		}
+	}
+}
+
+func TestResultMatchesDivide(t *testing.T) {
+	tests := []struct{ a, b int }{{10, 2}, {7, 3}, {-9, 2}, {1, 0}, {0, 0}}
+	for _, tt := range tests {
+		want, wantErr := divide(tt.a, tt.b)
+		r := generics.Try(divide(tt.a, tt.b))
+		got, err := r.Get()
+		if got != want || !errors.Is(err, wantErr) || r.IsOk() != (wantErr == nil) {
+			t.Errorf("Try(divide(%d, %d)).Get() = %d, %v; mau %d, %v", tt.a, tt.b, got, err, want, wantErr)
+		}
+		if wantErr != nil && r.OrElse(-1) != -1 {
+			t.Errorf("OrElse pada divide(%d, %d) = %d, mau -1", tt.a, tt.b, r.OrElse(-1))
+		}
+	}
+
+	halve := func(n int) (int, error) { return divide(n, 2) }
+	if got, err := generics.Then(generics.Try(divide(20, 2)), halve).Get(); got != 5 || err != nil {
+		t.Errorf("Then(20/2, /2) = %d, %v; mau 5", got, err)
+	}
+	if _, err := generics.Then(generics.Try(divide(1, 0)), halve).Get(); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Then setelah gagal: %v, mau ErrDivisionByZero", err)
+	}
+}
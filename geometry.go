@@ -0,0 +1,97 @@
+package main
+
+import (
+	"math"
+
+	"belajar-golang/internal/geometry"
+)
+
+// Point and Vertex reach the generic vector code in internal/geometry
+// through Vec; vecPoint converts back.
+
+func (p Point) Vec() geometry.Vec2[float64] {
+	return geometry.V(p.X, p.Y)
+}
+
+func (v Vertex) Vec() geometry.Vec2[int] {
+	return geometry.V(v.X, v.Y)
+}
+
+func (p Point) DistanceTo(q Point) float64 {
+	return p.Vec().Distance(q.Vec())
+}
+
+func vecPoint(v geometry.Vec2[float64]) Point {
+	return Point{v.X, v.Y}
+}
+
+func (m Matrix) ApplyVec(v geometry.Vec2[float64]) geometry.Vec2[float64] {
+	return m.Apply(vecPoint(v)).Vec()
+}
+
+func (m Matrix) Inverse() (Matrix, bool) {
+	det := m.Determinant()
+	if det == 0 || math.IsNaN(det) {
+		return Matrix{}, false
+	}
+	return Matrix{
+		A: m.D / det,
+		B: -m.B / det,
+		C: -m.C / det,
+		D: m.A / det,
+		E: (m.C*m.F - m.D*m.E) / det,
+		F: (m.B*m.E - m.A*m.F) / det,
+	}, true
+}
+
+func ShearMatrix(kx, ky float64) Matrix {
+	return Matrix{A: 1, B: ky, C: kx, D: 1}
+}
+
+func HullPolygon(points []Point) Polygon {
+	vecs := make([]geometry.Vec2[float64], len(points))
+	for i, p := range points {
+		vecs[i] = p.Vec()
+	}
+	hull := geometry.ConvexHull(vecs)
+	vertices := make([]Point, len(hull))
+	for i, v := range hull {
+		vertices[i] = vecPoint(v)
+	}
+	return Polygon{Vertices: vertices}
+}
+
+func geometryExample() {
+	a, b := Point{1, 2}, Point{4, 6}
+	logf("Jarak %v ke %v: %.2f\n", a, b, a.DistanceTo(b))
+
+	v, w := a.Vec(), b.Vec()
+	logln("v + w =", v.Add(w), " w - v =", w.Sub(v))
+	logln("v · w =", v.Dot(w), " v × w =", v.Cross(w))
+	if unit, ok := w.Sub(v).Normalize(); ok {
+		logf("Arah v→w (unit): (%.2f, %.2f)\n", unit.X, unit.Y)
+	}
+	r := geometry.V(1.0, 0.0).Rotate(math.Pi / 2)
+	logf("(1, 0) diputar 90°: (%.2f, %.2f)\n", r.X, r.Y)
+
+	vx := Vertex{3, 4}.Vec()
+	logln("Vertex sebagai Vec2[int]:", vx, "panjang:", vx.Length())
+	_, ok := geometry.V(math.MaxInt, 1).CheckedAdd(geometry.V(1, 1))
+	logln("MaxInt + 1 aman?", ok)
+
+	s1 := geometry.Segment[int]{A: geometry.V(0, 0), B: geometry.V(4, 4)}
+	s2 := geometry.Segment[int]{A: geometry.V(0, 4), B: geometry.V(4, 0)}
+	if p, ok := s1.Intersection(s2); ok {
+		logln("Segmen berpotongan di", p, "- Intersects:", s1.Intersects(s2))
+	}
+
+	m := TranslateMatrix(2, 0).Multiply(ShearMatrix(1, 0))
+	inv, _ := m.Inverse()
+	p := m.ApplyVec(geometry.V(1.0, 1.0))
+	logln("Shear lalu geser (1, 1):", p, "-> dibalik:", inv.ApplyVec(p))
+
+	cloud := []Point{{0, 0}, {2, 1}, {4, 0}, {3, 2}, {4, 4}, {1, 3}, {0, 4}, {2, 2}, {math.NaN(), 1}}
+	hull := HullPolygon(cloud)
+	logln("Convex hull:", hull.Vertices)
+	PrintShapeInfo(hull)
+}
@@ -0,0 +1,3 @@
+module belajar-golang
+
+go 1.24
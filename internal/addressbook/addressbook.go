@@ -0,0 +1,621 @@
+// Package addressbook is a contact store backed by a JSON-lines file, with
+// duplicate detection on email and phone and CSV and vCard import and
+// export. The addressbook command and the HTTP server in package main are
+// its front ends.
+package addressbook
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Person struct {
+	FirstName string
+	LastName  string
+	Age       int
+}
+
+type Address struct {
+	Street  string
+	City    string
+	ZipCode string
+}
+
+type Contact struct {
+	Email       string
+	Phone       string
+	HomeAddress Address
+}
+
+type Entry struct {
+	ID      int     `json:"id"`
+	Person  Person  `json:"person"`
+	Married bool    `json:"married"`
+	Contact Contact `json:"contact"`
+}
+
+// AddressBook keeps entries in memory and, when it has a path, rewrites
+// the whole JSON-lines file after every change. The file is replaced
+// atomically, so a crash leaves either the old or the new version.
+type AddressBook struct {
+	mu      sync.RWMutex
+	path    string
+	nextID  int
+	entries map[int]Entry
+}
+
+var ErrEntryNotFound = errors.New("kontak tidak ditemukan")
+
+type DuplicateError struct {
+	ExistingID int
+	Field      string
+	Value      string
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("duplikat dengan kontak #%d (%s: %s)", e.ExistingID, e.Field, e.Value)
+}
+
+type ImportReport struct {
+	Added      int
+	Duplicates []error
+}
+
+var csvHeader = []string{"id", "first_name", "last_name", "age", "married", "email", "phone", "street", "city", "zip_code"}
+
+// MaxLineSize is the longest line OpenAddressBook and ImportVCard accept,
+// well above bufio.Scanner's 64 KiB default so a contact with a long
+// address or an unfolded vCard line still loads.
+const MaxLineSize = 1 << 20
+
+func NewAddressBook() *AddressBook {
+	return &AddressBook{nextID: 1, entries: map[int]Entry{}}
+}
+
+func OpenAddressBook(path string) (*AddressBook, error) {
+	ab := NewAddressBook()
+	ab.path = path
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ab, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, MaxLineSize)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return nil, fmt.Errorf("%s baris %d: %w", path, lineNum, err)
+		}
+		ab.entries[e.ID] = e
+		if e.ID >= ab.nextID {
+			ab.nextID = e.ID + 1
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ab, nil
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone keeps only the digits and rewrites the Indonesian
+// country code, so "+62 812-345" and "0812345" compare equal.
+func NormalizePhone(phone string) string {
+	var sb strings.Builder
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	digits := sb.String()
+	if strings.HasPrefix(digits, "62") {
+		digits = "0" + digits[2:]
+	}
+	return digits
+}
+
+func (ab *AddressBook) findDuplicate(c Contact, ignoreID int) error {
+	email, phone := NormalizeEmail(c.Email), NormalizePhone(c.Phone)
+	for _, id := range ab.sortedIDs() {
+		if id == ignoreID {
+			continue
+		}
+		other := ab.entries[id].Contact
+		if email != "" && NormalizeEmail(other.Email) == email {
+			return &DuplicateError{ExistingID: id, Field: "email", Value: c.Email}
+		}
+		if phone != "" && NormalizePhone(other.Phone) == phone {
+			return &DuplicateError{ExistingID: id, Field: "telepon", Value: c.Phone}
+		}
+	}
+	return nil
+}
+
+func (ab *AddressBook) sortedIDs() []int {
+	ids := make([]int, 0, len(ab.entries))
+	for id := range ab.entries {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// Add stores e under a new ID and returns it; the ID in e is ignored.
+func (ab *AddressBook) Add(e Entry) (Entry, error) {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	if err := ab.findDuplicate(e.Contact, 0); err != nil {
+		return Entry{}, err
+	}
+	e.ID = ab.nextID
+	ab.entries[e.ID] = e
+	ab.nextID++
+
+	if err := ab.save(); err != nil {
+		delete(ab.entries, e.ID)
+		ab.nextID--
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+func (ab *AddressBook) Get(id int) (Entry, error) {
+	ab.mu.RLock()
+	defer ab.mu.RUnlock()
+
+	e, ok := ab.entries[id]
+	if !ok {
+		return Entry{}, fmt.Errorf("kontak #%d: %w", id, ErrEntryNotFound)
+	}
+	return e, nil
+}
+
+// Update replaces the entry with the given ID; the ID in e is ignored.
+func (ab *AddressBook) Update(id int, e Entry) error {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	old, ok := ab.entries[id]
+	if !ok {
+		return fmt.Errorf("kontak #%d: %w", id, ErrEntryNotFound)
+	}
+	if err := ab.findDuplicate(e.Contact, id); err != nil {
+		return err
+	}
+	e.ID = id
+	ab.entries[id] = e
+
+	if err := ab.save(); err != nil {
+		ab.entries[id] = old
+		return err
+	}
+	return nil
+}
+
+func (ab *AddressBook) Delete(id int) error {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	old, ok := ab.entries[id]
+	if !ok {
+		return fmt.Errorf("kontak #%d: %w", id, ErrEntryNotFound)
+	}
+	delete(ab.entries, id)
+
+	if err := ab.save(); err != nil {
+		ab.entries[id] = old
+		return err
+	}
+	return nil
+}
+
+func (ab *AddressBook) List() []Entry {
+	return ab.filter(func(Entry) bool { return true })
+}
+
+func (ab *AddressBook) filter(match func(Entry) bool) []Entry {
+	ab.mu.RLock()
+	defer ab.mu.RUnlock()
+
+	var result []Entry
+	for _, id := range ab.sortedIDs() {
+		if e := ab.entries[id]; match(e) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func (ab *AddressBook) SearchByName(query string) []Entry {
+	query = strings.ToLower(strings.TrimSpace(query))
+	return ab.filter(func(e Entry) bool {
+		full := strings.ToLower(e.Person.FirstName + " " + e.Person.LastName)
+		return strings.Contains(full, query)
+	})
+}
+
+func (ab *AddressBook) SearchByCity(city string) []Entry {
+	return ab.filter(func(e Entry) bool {
+		return strings.EqualFold(strings.TrimSpace(e.Contact.HomeAddress.City), strings.TrimSpace(city))
+	})
+}
+
+func (ab *AddressBook) SearchByZip(zip string) []Entry {
+	zip = strings.TrimSpace(zip)
+	return ab.filter(func(e Entry) bool {
+		return strings.HasPrefix(e.Contact.HomeAddress.ZipCode, zip)
+	})
+}
+
+// FindDuplicates groups entries that share a normalized email or phone.
+// Entries can only end up like this when the file was edited by hand,
+// because Add and Update refuse duplicates.
+func (ab *AddressBook) FindDuplicates() [][]Entry {
+	ab.mu.RLock()
+	defer ab.mu.RUnlock()
+
+	groups := map[string][]Entry{}
+	var keys []string
+	for _, id := range ab.sortedIDs() {
+		e := ab.entries[id]
+		for _, key := range []string{"email:" + NormalizeEmail(e.Contact.Email), "phone:" + NormalizePhone(e.Contact.Phone)} {
+			if strings.HasSuffix(key, ":") {
+				continue
+			}
+			if _, seen := groups[key]; !seen {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], e)
+		}
+	}
+
+	var dupes [][]Entry
+	for _, key := range keys {
+		if len(groups[key]) > 1 {
+			dupes = append(dupes, groups[key])
+		}
+	}
+	return dupes
+}
+
+func (ab *AddressBook) save() error {
+	if ab.path == "" {
+		return nil
+	}
+
+	// CreateTemp makes the file 0600; keep the mode the store already has.
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(ab.path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	dir := filepath.Dir(ab.path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(ab.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+	}
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, id := range ab.sortedIDs() {
+		if err := enc.Encode(ab.entries[id]); err != nil {
+			tmp.Close()
+			return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), ab.path); err != nil {
+		return fmt.Errorf("gagal menyimpan buku alamat: %w", err)
+	}
+	return nil
+}
+
+func (ab *AddressBook) ExportCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, e := range ab.List() {
+		addr := e.Contact.HomeAddress
+		record := []string{
+			strconv.Itoa(e.ID), e.Person.FirstName, e.Person.LastName,
+			strconv.Itoa(e.Person.Age), strconv.FormatBool(e.Married),
+			e.Contact.Email, e.Contact.Phone, addr.Street, addr.City, addr.ZipCode,
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ImportCSV adds every row as a new entry; the id column is ignored.
+// Rows that duplicate an existing contact are skipped and reported. The
+// whole file is parsed first, so a malformed row adds nothing.
+func (ab *AddressBook) ImportCSV(r io.Reader) (ImportReport, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return ImportReport{}, fmt.Errorf("csv: gagal membaca header: %w", err)
+	}
+	col := map[string]int{}
+	for i, name := range header {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	get := func(record []string, name string) string {
+		if i, ok := col[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []Entry
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return ImportReport{}, fmt.Errorf("csv: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+
+		age := 0
+		if s := get(record, "age"); s != "" {
+			if age, err = strconv.Atoi(s); err != nil {
+				return ImportReport{}, fmt.Errorf("csv baris %d: umur tidak valid %q", line, s)
+			}
+		}
+		rows = append(rows, Entry{
+			Person: Person{
+				FirstName: get(record, "first_name"),
+				LastName:  get(record, "last_name"),
+				Age:       age,
+			},
+			Married: get(record, "married") == "true",
+			Contact: Contact{
+				Email: get(record, "email"),
+				Phone: get(record, "phone"),
+				HomeAddress: Address{
+					Street:  get(record, "street"),
+					City:    get(record, "city"),
+					ZipCode: get(record, "zip_code"),
+				},
+			},
+		})
+	}
+	return ab.addBatch(rows)
+}
+
+// addBatch adds rows under one lock and writes the file once, so an import
+// costs a single save instead of one per row. Rows that duplicate an
+// existing contact, or an earlier row, are skipped and reported. If the
+// save fails, none of the rows are kept.
+func (ab *AddressBook) addBatch(rows []Entry) (ImportReport, error) {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	var report ImportReport
+	firstID := ab.nextID
+	for _, row := range rows {
+		if err := ab.findDuplicate(row.Contact, 0); err != nil {
+			report.Duplicates = append(report.Duplicates, err)
+			continue
+		}
+		row.ID = ab.nextID
+		ab.entries[row.ID] = row
+		ab.nextID++
+		report.Added++
+	}
+	if report.Added == 0 {
+		return report, nil
+	}
+
+	if err := ab.save(); err != nil {
+		for id := firstID; id < ab.nextID; id++ {
+			delete(ab.entries, id)
+		}
+		ab.nextID = firstID
+		return ImportReport{}, err
+	}
+	return report, nil
+}
+
+var vcardEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\n", `\n`)
+
+func vcardEscape(s string) string {
+	return vcardEscaper.Replace(s)
+}
+
+func vcardUnescape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			if s[i] == 'n' || s[i] == 'N' {
+				sb.WriteByte('\n')
+			} else {
+				sb.WriteByte(s[i])
+			}
+			continue
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// splitVCardValue splits on unescaped separators, e.g. the ';' between the
+// components of N and ADR.
+func splitVCardValue(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == sep {
+			parts = append(parts, vcardUnescape(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, vcardUnescape(s[start:]))
+}
+
+// writeVCardLine folds lines longer than 75 octets as RFC 6350 requires,
+// without splitting a multi-byte UTF-8 sequence. The space that starts a
+// continuation line counts towards its 75 octets.
+func writeVCardLine(w *bufio.Writer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	w.WriteString(line + "\r\n")
+}
+
+func (ab *AddressBook) ExportVCard(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, e := range ab.List() {
+		p, c, addr := e.Person, e.Contact, e.Contact.HomeAddress
+		writeVCardLine(bw, "BEGIN:VCARD")
+		writeVCardLine(bw, "VERSION:4.0")
+		writeVCardLine(bw, "FN:"+vcardEscape(strings.TrimSpace(p.FirstName+" "+p.LastName)))
+		writeVCardLine(bw, "N:"+vcardEscape(p.LastName)+";"+vcardEscape(p.FirstName)+";;;")
+		if c.Email != "" {
+			writeVCardLine(bw, "EMAIL:"+vcardEscape(c.Email))
+		}
+		if c.Phone != "" {
+			writeVCardLine(bw, "TEL;VALUE=text:"+vcardEscape(c.Phone))
+		}
+		if addr != (Address{}) {
+			writeVCardLine(bw, "ADR;TYPE=home:;;"+vcardEscape(addr.Street)+";"+vcardEscape(addr.City)+";;"+vcardEscape(addr.ZipCode)+";")
+		}
+		if p.Age > 0 {
+			writeVCardLine(bw, "X-AGE:"+strconv.Itoa(p.Age))
+		}
+		if e.Married {
+			writeVCardLine(bw, "X-MARRIED:true")
+		}
+		writeVCardLine(bw, "END:VCARD")
+	}
+	return bw.Flush()
+}
+
+// ImportVCard adds every card as a new entry, with the same duplicate
+// handling as ImportCSV.
+func (ab *AddressBook) ImportVCard(r io.Reader) (ImportReport, error) {
+	// Unfold continuation lines first: a line starting with a space or tab
+	// belongs to the previous one.
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, MaxLineSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return ImportReport{}, err
+	}
+
+	var rows []Entry
+	var e Entry
+	inCard := false
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		nameAndParams, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return ImportReport{}, fmt.Errorf("vcard baris %d: tidak ada ':'", i+1)
+		}
+		name, _, _ := strings.Cut(nameAndParams, ";")
+		name = strings.ToUpper(name)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCARD"):
+			e, inCard = Entry{}, true
+		case !inCard:
+			return ImportReport{}, fmt.Errorf("vcard baris %d: %s di luar BEGIN:VCARD", i+1, name)
+		case name == "END":
+			rows = append(rows, e)
+			inCard = false
+		case name == "N":
+			parts := splitVCardValue(value, ';')
+			e.Person.LastName = parts[0]
+			if len(parts) > 1 {
+				e.Person.FirstName = parts[1]
+			}
+		case name == "FN" && e.Person.FirstName == "" && e.Person.LastName == "":
+			e.Person.FirstName = vcardUnescape(value)
+		case name == "EMAIL" && e.Contact.Email == "":
+			e.Contact.Email = vcardUnescape(value)
+		case name == "TEL" && e.Contact.Phone == "":
+			e.Contact.Phone = strings.TrimPrefix(vcardUnescape(value), "tel:")
+		case name == "ADR":
+			parts := splitVCardValue(value, ';')
+			for len(parts) < 7 {
+				parts = append(parts, "")
+			}
+			e.Contact.HomeAddress = Address{Street: parts[2], City: parts[3], ZipCode: parts[5]}
+		case name == "X-AGE":
+			e.Person.Age, _ = strconv.Atoi(value)
+		case name == "X-MARRIED":
+			e.Married = value == "true"
+		}
+	}
+	if inCard {
+		return ImportReport{}, errors.New("vcard: END:VCARD tidak ditemukan")
+	}
+	return ab.addBatch(rows)
+}
@@ -0,0 +1,160 @@
+package addressbook
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWriteVCardLineFolding(t *testing.T) {
+	tests := []string{
+		"NOTE:" + strings.Repeat("a", 300),
+		"NOTE:" + strings.Repeat("é", 120),
+		"FN:" + strings.Repeat("x", 72),
+		"FN:" + strings.Repeat("x", 73),
+		"FN:" + strings.Repeat("x", 150),
+	}
+	for _, line := range tests {
+		var buf bytes.Buffer
+		w := bufio.NewWriter(&buf)
+		writeVCardLine(w, line)
+		w.Flush()
+
+		physical := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+		var unfolded strings.Builder
+		for i, p := range physical {
+			if len(p) > 75 {
+				t.Errorf("baris %d panjangnya %d oktet: %q", i, len(p), p)
+			}
+			if i > 0 {
+				if !strings.HasPrefix(p, " ") {
+					t.Fatalf("baris lanjutan %d tidak diawali spasi: %q", i, p)
+				}
+				p = p[1:]
+			}
+			if !utf8.ValidString(p) {
+				t.Errorf("baris %d memotong karakter UTF-8: %q", i, p)
+			}
+			unfolded.WriteString(p)
+		}
+		if unfolded.String() != line {
+			t.Errorf("hasil unfold berbeda dari aslinya (%d oktet)", len(line))
+		}
+	}
+}
+
+func TestVCardRoundTrip(t *testing.T) {
+	src := NewAddressBook()
+	src.Add(Entry{
+		Person:  Person{FirstName: "Siti", LastName: "Aminah; Putri", Age: 32},
+		Married: true,
+		Contact: Contact{
+			Email:       "siti@example.com",
+			Phone:       "0813-0000-1111",
+			HomeAddress: Address{Street: "Jl. Asia Afrika 8, " + strings.Repeat("Blok ", 20), City: "Bandung", ZipCode: "40111"},
+		},
+	})
+	var buf bytes.Buffer
+	if err := src.ExportVCard(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := NewAddressBook()
+	report, err := dst.ImportVCard(&buf)
+	if err != nil || report.Added != 1 {
+		t.Fatalf("ImportVCard = %+v, %v", report, err)
+	}
+	want, got := src.List()[0], dst.List()[0]
+	if got != want {
+		t.Errorf("hasil impor\n  %+v\nmau\n  %+v", got, want)
+	}
+}
+
+func TestImportCSVBatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kontak.jsonl")
+	ab, err := OpenAddressBook(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ab.Add(Entry{Person: Person{FirstName: "Andi"}, Contact: Contact{Email: "andi@example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	csvData := "first_name,last_name,age,email,phone\n" +
+		"Budi,Santoso,30,budi@example.com,0811\n" +
+		"Andi,W.,28,ANDI@example.com,\n" + // duplikat kontak lama
+		"Citra,Lestari,25,citra@example.com,0822\n" +
+		"Budi,S.,31,,+62 811\n" // duplikat baris di atasnya
+	report, err := ab.ImportCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Added != 2 || len(report.Duplicates) != 2 {
+		t.Errorf("laporan %+v, mau 2 ditambah dan 2 duplikat", report)
+	}
+
+	reopened, err := OpenAddressBook(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(reopened.List()); n != 3 {
+		t.Errorf("file berisi %d kontak, mau 3", n)
+	}
+
+	// A malformed row rejects the whole import.
+	bad := "first_name,age,email\nDewi,29,dewi@example.com\nEko,tiga puluh,eko@example.com\n"
+	if _, err := ab.ImportCSV(strings.NewReader(bad)); err == nil {
+		t.Fatal("umur tidak valid tidak menghasilkan error")
+	}
+	if n := len(ab.List()); n != 3 {
+		t.Errorf("setelah impor gagal ada %d kontak, mau tetap 3", n)
+	}
+}
+
+func TestSaveKeepsFileMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kontak.jsonl")
+	if err := os.WriteFile(path, nil, 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, 0o640); err != nil {
+		t.Fatal(err)
+	}
+	ab, err := OpenAddressBook(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ab.Add(Entry{Person: Person{FirstName: "Andi"}, Contact: Contact{Email: "andi@example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o640 {
+		t.Errorf("mode file %v, mau -rw-r-----", mode)
+	}
+}
+
+func TestOpenAddressBookLongLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kontak.jsonl")
+	ab, err := OpenAddressBook(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	street := strings.Repeat("Jl. Panjang ", 10000) // lebih dari 64 KiB
+	if _, err := ab.Add(Entry{Person: Person{FirstName: "Andi"}, Contact: Contact{HomeAddress: Address{Street: street}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenAddressBook(path)
+	if err != nil {
+		t.Fatalf("membuka baris %d oktet: %v", len(street), err)
+	}
+	if got := reopened.List()[0].Contact.HomeAddress.Street; got != street {
+		t.Errorf("alamat terbaca %d oktet, mau %d", len(got), len(street))
+	}
+}
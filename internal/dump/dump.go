@@ -0,0 +1,411 @@
+// Package dump is what describe() grows into once %v and %T are not
+// enough: it walks any value with reflect and prints it as an indented
+// tree, showing struct tags, embedded and unexported fields, map entries
+// in sorted order, and cycle detection for pointers, maps and slices.
+package dump
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Options struct {
+	Color bool
+	// HideAddresses replaces memory addresses with numbered references
+	// (&1, &2, …) so the output is stable enough for golden files.
+	HideAddresses bool
+	// MaxDepth stops descending below this many levels; 0 means no limit.
+	MaxDepth int
+	// Indent defaults to two spaces.
+	Indent string
+}
+
+type dumper struct {
+	opts   Options
+	sb     strings.Builder
+	onPath map[pathKey]bool // references being printed; seeing one again is a cycle
+	refs   map[uintptr]int
+}
+
+// pathKey identifies a pointer, map or slice on the current path. The
+// address alone is not enough: a struct and its first field share one, so
+// the key includes the type, and a slice includes its length because
+// s[:1] shares its address with s but is a different value. Only the same
+// value inside itself is a cycle.
+type pathKey struct {
+	p   uintptr
+	typ reflect.Type
+	len int
+}
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiDim     = "\x1b[2m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiYellow  = "\x1b[33m"
+	ansiMagenta = "\x1b[35m"
+	ansiCyan    = "\x1b[36m"
+)
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// DefaultOptions enables color only when stdout is a terminal and the
+// NO_COLOR convention (https://no-color.org) is not in effect.
+func DefaultOptions() Options {
+	color := false
+	if fi, err := os.Stdout.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+		color = os.Getenv("NO_COLOR") == ""
+	}
+	return Options{Color: color}
+}
+
+func Dump(v any) {
+	Fdump(os.Stdout, v, DefaultOptions())
+}
+
+func Sdump(v any, opts Options) string {
+	d := &dumper{opts: opts, onPath: map[pathKey]bool{}, refs: map[uintptr]int{}}
+	if d.opts.Indent == "" {
+		d.opts.Indent = "  "
+	}
+	d.value(reflect.ValueOf(v), 0)
+	d.sb.WriteByte('\n')
+	return d.sb.String()
+}
+
+func Fdump(w io.Writer, v any, opts Options) error {
+	_, err := io.WriteString(w, Sdump(v, opts))
+	return err
+}
+
+func (d *dumper) paint(color, s string) string {
+	if !d.opts.Color {
+		return s
+	}
+	return color + s + ansiReset
+}
+
+func (d *dumper) typeName(t reflect.Type) string {
+	return d.paint(ansiCyan, "("+t.String()+")")
+}
+
+func (d *dumper) newline(depth int) {
+	d.sb.WriteByte('\n')
+	d.sb.WriteString(strings.Repeat(d.opts.Indent, depth))
+}
+
+// address prints p, or its reference number when addresses are hidden.
+func (d *dumper) address(p uintptr) string {
+	if !d.opts.HideAddresses {
+		return d.paint(ansiDim, fmt.Sprintf("0x%x", p))
+	}
+	id, ok := d.refs[p]
+	if !ok {
+		id = len(d.refs) + 1
+		d.refs[p] = id
+	}
+	return d.paint(ansiDim, "&"+strconv.Itoa(id))
+}
+
+func (d *dumper) value(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.sb.WriteString(d.paint(ansiDim, "nil"))
+		return
+	}
+	t := v.Type()
+
+	if s, ok := d.stringer(v); ok {
+		fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiGreen, s))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiDim, "nil"))
+			return
+		}
+		d.value(v.Elem(), depth)
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiDim, "nil"))
+			return
+		}
+		p := v.Pointer()
+		fmt.Fprintf(&d.sb, "%s(%s) ", d.typeName(t), d.address(p))
+		k := pathKey{p: p, typ: t}
+		if d.enter(k) {
+			return
+		}
+		d.value(v.Elem(), depth)
+		delete(d.onPath, k)
+
+	case reflect.Struct:
+		d.structValue(v, depth)
+
+	case reflect.Map:
+		d.mapValue(v, depth)
+
+	case reflect.Slice, reflect.Array:
+		d.listValue(v, depth)
+
+	case reflect.Chan:
+		if v.IsNil() {
+			fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiDim, "nil"))
+			return
+		}
+		fmt.Fprintf(&d.sb, "%s(%s) %s", d.typeName(t), d.address(v.Pointer()),
+			d.paint(ansiDim, fmt.Sprintf("(len=%d cap=%d)", v.Len(), v.Cap())))
+
+	case reflect.Func:
+		if v.IsNil() {
+			fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiDim, "nil"))
+			return
+		}
+		name := "?"
+		if fn := runtime.FuncForPC(v.Pointer()); fn != nil {
+			name = fn.Name()
+		}
+		fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiYellow, name))
+
+	default:
+		fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.scalar(v))
+	}
+}
+
+// stringer uses String() or Error() for types such as time.Time whose
+// fields are implementation details. The method can only be called on
+// exported values, and a panicking method falls back to the raw fields.
+func (d *dumper) stringer(v reflect.Value) (s string, ok bool) {
+	if !v.CanInterface() || v.Kind() == reflect.Interface {
+		return "", false
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return "", false
+	}
+	t := v.Type()
+	if !t.Implements(stringerType) && !t.Implements(errorType) {
+		return "", false
+	}
+	defer func() {
+		if recover() != nil {
+			s, ok = "", false
+		}
+	}()
+	switch x := v.Interface().(type) {
+	case error:
+		return x.Error(), true
+	case fmt.Stringer:
+		return x.String(), true
+	}
+	return "", false
+}
+
+// scalar formats basic kinds through the reflect getters, which, unlike
+// Interface(), also work on unexported fields.
+func (d *dumper) scalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return d.paint(ansiGreen, strconv.Quote(v.String()))
+	case reflect.Bool:
+		return d.paint(ansiMagenta, strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return d.paint(ansiMagenta, strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return d.paint(ansiMagenta, strconv.FormatUint(v.Uint(), 10))
+	case reflect.Uintptr, reflect.UnsafePointer:
+		if d.opts.HideAddresses {
+			return d.paint(ansiDim, "0x…")
+		}
+		// Uint panics on an unsafe.Pointer; Pointer does not take a uintptr.
+		var p uintptr
+		if v.Kind() == reflect.UnsafePointer {
+			p = v.Pointer()
+		} else {
+			p = uintptr(v.Uint())
+		}
+		return d.paint(ansiDim, fmt.Sprintf("0x%x", p))
+	case reflect.Float32, reflect.Float64:
+		return d.paint(ansiMagenta, strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))
+	case reflect.Complex64, reflect.Complex128:
+		return d.paint(ansiMagenta, strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits()))
+	}
+	return fmt.Sprint(v)
+}
+
+// enter marks k as being printed. If it already is, enter prints <siklus>
+// and reports true; otherwise the caller deletes k from onPath when done.
+func (d *dumper) enter(k pathKey) bool {
+	if d.onPath[k] {
+		d.sb.WriteString(d.paint(ansiRed, "<siklus>"))
+		return true
+	}
+	d.onPath[k] = true
+	return false
+}
+
+func (d *dumper) tooDeep(depth int) bool {
+	if d.opts.MaxDepth > 0 && depth >= d.opts.MaxDepth {
+		d.sb.WriteString(d.paint(ansiDim, "{…}"))
+		return true
+	}
+	return false
+}
+
+func (d *dumper) structValue(v reflect.Value, depth int) {
+	t := v.Type()
+	d.sb.WriteString(d.typeName(t))
+	if t.NumField() == 0 {
+		d.sb.WriteString(" {}")
+		return
+	}
+	d.sb.WriteByte(' ')
+	if d.tooDeep(depth) {
+		return
+	}
+	d.sb.WriteByte('{')
+	for i := range t.NumField() {
+		f := t.Field(i)
+		d.newline(depth + 1)
+		d.sb.WriteString(d.paint(ansiYellow, f.Name))
+		var markers []string
+		if f.Anonymous {
+			markers = append(markers, "embedded")
+		}
+		if !f.IsExported() {
+			markers = append(markers, "unexported")
+		}
+		if len(markers) > 0 {
+			d.sb.WriteString(" " + d.paint(ansiDim, "["+strings.Join(markers, ", ")+"]"))
+		}
+		if f.Tag != "" {
+			d.sb.WriteString(" " + d.paint(ansiDim, "`"+string(f.Tag)+"`"))
+		}
+		d.sb.WriteString(": ")
+		d.value(v.Field(i), depth+1)
+	}
+	d.newline(depth)
+	d.sb.WriteByte('}')
+}
+
+func (d *dumper) listValue(v reflect.Value, depth int) {
+	t := v.Type()
+	if v.Kind() == reflect.Slice && v.IsNil() {
+		fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiDim, "nil"))
+		return
+	}
+
+	d.sb.WriteString(d.typeName(t))
+	if v.Kind() == reflect.Slice {
+		d.sb.WriteString(d.paint(ansiDim, fmt.Sprintf(" (len=%d cap=%d)", v.Len(), v.Cap())))
+	}
+	if t.Elem().Kind() == reflect.Uint8 {
+		// Byte slices read better as hex than as one number per line.
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = fmt.Sprintf("%02x", v.Index(i).Uint())
+		}
+		d.sb.WriteString(" " + d.paint(ansiMagenta, strings.Join(parts, " ")))
+		return
+	}
+	if v.Len() == 0 {
+		d.sb.WriteString(" {}")
+		return
+	}
+	d.sb.WriteByte(' ')
+	if v.Kind() == reflect.Slice {
+		k := pathKey{p: v.Pointer(), typ: t, len: v.Len()}
+		if d.enter(k) {
+			return
+		}
+		defer delete(d.onPath, k)
+	}
+	if d.tooDeep(depth) {
+		return
+	}
+	d.sb.WriteByte('{')
+	for i := range v.Len() {
+		d.newline(depth + 1)
+		fmt.Fprintf(&d.sb, "%s: ", d.paint(ansiDim, "["+strconv.Itoa(i)+"]"))
+		d.value(v.Index(i), depth+1)
+	}
+	d.newline(depth)
+	d.sb.WriteByte('}')
+}
+
+func (d *dumper) mapValue(v reflect.Value, depth int) {
+	t := v.Type()
+	if v.IsNil() {
+		fmt.Fprintf(&d.sb, "%s %s", d.typeName(t), d.paint(ansiDim, "nil"))
+		return
+	}
+	fmt.Fprintf(&d.sb, "%s%s", d.typeName(t), d.paint(ansiDim, fmt.Sprintf(" (len=%d)", v.Len())))
+	if v.Len() == 0 {
+		d.sb.WriteString(" {}")
+		return
+	}
+	d.sb.WriteByte(' ')
+	k := pathKey{p: v.Pointer(), typ: t}
+	if d.enter(k) {
+		return
+	}
+	defer delete(d.onPath, k)
+	if d.tooDeep(depth) {
+		return
+	}
+
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return lessValue(keys[i], keys[j]) })
+	d.sb.WriteByte('{')
+	for _, k := range keys {
+		d.newline(depth + 1)
+		// Keys are written inline: usually strings or numbers, and a
+		// multi-line key would make the tree unreadable.
+		key := &dumper{opts: d.opts, onPath: d.onPath, refs: d.refs}
+		key.opts.MaxDepth = 1
+		key.value(k, 0)
+		d.sb.WriteString(strings.ReplaceAll(key.sb.String(), "\n", " "))
+		d.sb.WriteString(": ")
+		d.value(v.MapIndex(k), depth+1)
+	}
+	d.newline(depth)
+	d.sb.WriteByte('}')
+}
+
+// lessValue orders map keys: numbers numerically, strings and everything
+// else by their printed form, so output never depends on map iteration.
+func lessValue(a, b reflect.Value) bool {
+	for a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+	for b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+	if a.Kind() != b.Kind() {
+		return a.Kind() < b.Kind()
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
@@ -0,0 +1,79 @@
+package dump
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+type node struct {
+	Name     string
+	Next     *node
+	Children []*node
+}
+
+type inner struct{ N int }
+
+type outer struct {
+	In inner
+	P  *inner
+}
+
+func TestDumpCycles(t *testing.T) {
+	self := map[string]any{"nama": "diri"}
+	self["diri"] = self
+	list := []any{"awal", nil}
+	list[1] = list
+	inner := []any{"dalam"}
+	nested := map[string]any{"a": inner, "b": inner}
+	shared := []any{nil, nil}
+	shared[0] = shared[:1]
+	a := &node{Name: "a"}
+	a.Next = &node{Name: "b", Next: a}
+	o := &outer{}
+	o.P = &o.In
+	back := &outer{}
+	back.P = &back.In
+
+	tests := []struct {
+		name   string
+		v      any
+		cycles int
+	}{
+		{"map berisi dirinya", self, 1},
+		{"slice berisi dirinya", list, 1},
+		{"pointer melingkar", a, 1},
+		// The same slice twice side by side is not a cycle.
+		{"slice yang sama dua kali", nested, 0},
+		// s[:1] shares memory with s; it contains itself, but s does not.
+		{"potongan berisi dirinya", shared, 1},
+		// &o and &o.In are one address but two values of different types.
+		{"pointer ke field pertama", o, 0},
+		{"dua pointer ke field pertama", []*outer{back, back}, 0},
+	}
+	for _, tt := range tests {
+		out := Sdump(tt.v, Options{HideAddresses: true})
+		if got := strings.Count(out, "<siklus>"); got != tt.cycles {
+			t.Errorf("%s: %d <siklus>, mau %d\n%s", tt.name, got, tt.cycles, out)
+		}
+	}
+}
+
+func TestDumpPointers(t *testing.T) {
+	n := 7
+	v := struct {
+		P    unsafe.Pointer
+		p    unsafe.Pointer
+		Addr uintptr
+	}{unsafe.Pointer(&n), unsafe.Pointer(&n), 0xbeef}
+
+	out := Sdump(v, Options{})
+	want := fmt.Sprintf("(unsafe.Pointer) %p", &n)
+	if strings.Count(out, want) != 2 || !strings.Contains(out, "(uintptr) 0xbeef") {
+		t.Errorf("Sdump menulis\n%s\nmau dua kali %q dan (uintptr) 0xbeef", out, want)
+	}
+	if out := Sdump(v, Options{HideAddresses: true}); strings.Contains(out, "0xbeef") {
+		t.Errorf("HideAddresses masih menulis alamat:\n%s", out)
+	}
+}
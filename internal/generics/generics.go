@@ -0,0 +1,446 @@
+// Package generics holds the type-parameterized functions and containers
+// of the generics chapter. The lessons that compare them with the
+// non-generic examples live in package main (generics.go).
+package generics
+
+import (
+	"cmp"
+	"container/heap"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Number is every built-in integer and floating-point type, including
+// named types built on them such as time.Duration.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+var ErrEmptyResult = errors.New("Result kosong: tidak ada nilai maupun error")
+
+func Map[T, U any](s []T, f func(T) U) []U {
+	out := make([]U, len(s))
+	for i, v := range s {
+		out[i] = f(v)
+	}
+	return out
+}
+
+func Filter[T any](s []T, keep func(T) bool) []T {
+	var out []T
+	for _, v := range s {
+		if keep(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Reduce folds s into a single value, starting from initial. The
+// accumulator may have a different type from the elements.
+func Reduce[T, A any](s []T, initial A, f func(A, T) A) A {
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Sum adds values of any Number type; the sum of none is zero.
+func Sum[T Number](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// Max returns the largest value; ok is false when there are none.
+func Max[T Number](values ...T) (largest T, ok bool) {
+	if len(values) == 0 {
+		return largest, false
+	}
+	largest = values[0]
+	for _, v := range values[1:] {
+		if v > largest {
+			largest = v
+		}
+	}
+	return largest, true
+}
+
+// Set is a map[T]struct{} with set operations. The zero value is an
+// empty set ready to use.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{}
+	for _, v := range items {
+		s.Add(v)
+	}
+	return s
+}
+
+func (s *Set[T]) Add(v T) {
+	if s.items == nil {
+		s.items = map[T]struct{}{}
+	}
+	s.items[v] = struct{}{}
+}
+
+func (s *Set[T]) Remove(v T) {
+	delete(s.items, v)
+}
+
+func (s *Set[T]) Has(v T) bool {
+	_, ok := s.items[v]
+	return ok
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns the elements in no particular order; see SortedItems.
+func (s *Set[T]) Items() []T {
+	out := make([]T, 0, len(s.items))
+	for v := range s.items {
+		out = append(out, v)
+	}
+	return out
+}
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	out := NewSet(s.Items()...)
+	for v := range other.items {
+		out.Add(v)
+	}
+	return out
+}
+
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	out := &Set[T]{}
+	for v := range s.items {
+		if other.Has(v) {
+			out.Add(v)
+		}
+	}
+	return out
+}
+
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	out := &Set[T]{}
+	for v := range s.items {
+		if !other.Has(v) {
+			out.Add(v)
+		}
+	}
+	return out
+}
+
+// SortedItems is a function rather than a method because sorting needs
+// the stricter cmp.Ordered constraint, and a method cannot narrow the
+// constraint of its receiver.
+func SortedItems[T cmp.Ordered](s *Set[T]) []T {
+	items := s.Items()
+	slices.Sort(items)
+	return items
+}
+
+// OrderedMap remembers the order in which keys were first set, which a
+// plain map does not: fmt prints maps sorted by key only because it sorts
+// them itself.
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: map[K]V{}}
+}
+
+// Set stores value under key. Updating an existing key keeps its place.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	if _, ok := m.values[key]; !ok {
+		return false
+	}
+	delete(m.values, key)
+	m.keys = slices.DeleteFunc(m.keys, func(k K) bool {
+		return k == key
+	})
+	return true
+}
+
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.keys)
+}
+
+func (m *OrderedMap[K, V]) Keys() []K {
+	return slices.Clone(m.keys)
+}
+
+func (m *OrderedMap[K, V]) Values() []V {
+	return Map(m.keys, func(k K) V {
+		return m.values[k]
+	})
+}
+
+func (m *OrderedMap[K, V]) String() string {
+	parts := Map(m.keys, func(k K) string {
+		return fmt.Sprintf("%v:%v", k, m.values[k])
+	})
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// Stack is last in, first out.
+type Stack[T any] struct {
+	items []T
+}
+
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	v := s.items[len(s.items)-1]
+	s.items[len(s.items)-1] = zero // drop the reference for the GC
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Queue is first in, first out. Dequeue advances a head index instead of
+// shifting every element; the consumed front is dropped once it makes up
+// half of the slice.
+type Queue[T any] struct {
+	items []T
+	head  int
+}
+
+func (q *Queue[T]) Enqueue(v T) {
+	q.items = append(q.items, v)
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.head == len(q.items) {
+		return zero, false
+	}
+	v := q.items[q.head]
+	q.items[q.head] = zero
+	q.head++
+	if q.head > len(q.items)/2 {
+		q.items = slices.Clone(q.items[q.head:])
+		q.head = 0
+	}
+	return v, true
+}
+
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.head == len(q.items) {
+		var zero T
+		return zero, false
+	}
+	return q.items[q.head], true
+}
+
+func (q *Queue[T]) Len() int {
+	return len(q.items) - q.head
+}
+
+// PriorityQueue pops the element that sorts first under less. It is a
+// typed front for container/heap, whose interface works on any.
+type PriorityQueue[T any] struct {
+	h *pqHeap[T]
+}
+
+type pqHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *pqHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *pqHeap[T]) Less(i, j int) bool {
+	return h.less(h.items[i], h.items[j])
+}
+
+func (h *pqHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *pqHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(T))
+}
+
+func (h *pqHeap[T]) Pop() any {
+	last := len(h.items) - 1
+	v := h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	return v
+}
+
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: &pqHeap[T]{less: less}}
+}
+
+func (pq *PriorityQueue[T]) Push(v T) {
+	heap.Push(pq.h, v)
+}
+
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if pq.h.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(pq.h).(T), true
+}
+
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if pq.h.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.h.items[0], true
+}
+
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.h.Len()
+}
+
+// Result carries either a value or an error, the (T, error) pair of a
+// function return packed into one value that can be stored in a slice or
+// sent over a channel.
+type Result[T any] struct {
+	value T
+	err   error
+	ok    bool
+}
+
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v, ok: true}
+}
+
+func Fail[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Try packs the return values of an ordinary function call:
+// Try(strconv.Atoi(s)).
+func Try[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Fail[T](err)
+	}
+	return Ok(v)
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.ok
+}
+
+// Get unpacks the result back into the usual (T, error) pair.
+func (r Result[T]) Get() (T, error) {
+	if !r.ok && r.err == nil {
+		return r.value, ErrEmptyResult
+	}
+	return r.value, r.err
+}
+
+func (r Result[T]) OrElse(fallback T) T {
+	if !r.ok {
+		return fallback
+	}
+	return r.value
+}
+
+func (r Result[T]) String() string {
+	if !r.ok {
+		_, err := r.Get()
+		return fmt.Sprintf("Fail(%v)", err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// Then continues with f only if r holds a value; an error is passed
+// along untouched.
+func Then[T, U any](r Result[T], f func(T) (U, error)) Result[U] {
+	v, err := r.Get()
+	if err != nil {
+		return Fail[U](err)
+	}
+	return Try(f(v))
+}
+
+// Option is a value that may be absent, the job a nil pointer often does,
+// without the pointer.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Option[T]) OrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
@@ -0,0 +1,230 @@
+package generics
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+func TestMapFilterReduce(t *testing.T) {
+	words := []string{"go", "itu", "sederhana"}
+	if got := Map(words, func(w string) int { return len(w) }); !slices.Equal(got, []int{2, 3, 9}) {
+		t.Errorf("Map(len) = %v, mau [2 3 9]", got)
+	}
+	if got := Map([]int(nil), strconv.Itoa); got == nil || len(got) != 0 {
+		t.Errorf("Map(nil) = %#v, mau slice kosong", got)
+	}
+	if got := Filter([]int{1, 2, 3, 4}, func(n int) bool { return n > 4 }); got != nil {
+		t.Errorf("Filter tanpa hasil = %v, mau nil", got)
+	}
+	joined := Reduce([]int{1, 2, 3}, "", func(acc string, n int) string {
+		return acc + strconv.Itoa(n)
+	})
+	if joined != "123" {
+		t.Errorf("Reduce = %q, mau \"123\"", joined)
+	}
+	if got := Reduce([]int(nil), 42, func(acc, n int) int { return acc + n }); got != 42 {
+		t.Errorf("Reduce(nil) = %d, mau nilai awal 42", got)
+	}
+}
+
+func TestSumMax(t *testing.T) {
+	if got := Sum[int](); got != 0 {
+		t.Errorf("Sum() = %d, mau 0", got)
+	}
+	if got := Sum(time.Second, 500*time.Millisecond); got != 1500*time.Millisecond {
+		t.Errorf("Sum(Duration) = %v, mau 1.5s", got)
+	}
+	tests := []struct {
+		in   []float64
+		want float64
+		ok   bool
+	}{
+		{nil, 0, false},
+		{[]float64{-3}, -3, true},
+		{[]float64{-3, -1, -2}, -1, true},
+		{[]float64{1, 9, 9, 4}, 9, true},
+	}
+	for _, tt := range tests {
+		if got, ok := Max(tt.in...); got != tt.want || ok != tt.ok {
+			t.Errorf("Max(%v) = %v, %v; mau %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	var zero Set[string]
+	if zero.Has("a") || zero.Len() != 0 {
+		t.Error("Set nol tidak kosong")
+	}
+	zero.Add("a")
+	zero.Add("a")
+	if zero.Len() != 1 {
+		t.Errorf("Len setelah Add ganda = %d, mau 1", zero.Len())
+	}
+
+	a := NewSet(1, 2, 3)
+	b := NewSet(3, 4)
+	tests := []struct {
+		name string
+		got  *Set[int]
+		want []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4}},
+		{"Intersect", a.Intersect(b), []int{3}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"Difference kosong", b.Difference(NewSet(3, 4, 5)), nil},
+	}
+	for _, tt := range tests {
+		if got := SortedItems(tt.got); !slices.Equal(got, tt.want) {
+			t.Errorf("%s = %v, mau %v", tt.name, got, tt.want)
+		}
+	}
+	if !slices.Equal(SortedItems(a), []int{1, 2, 3}) {
+		t.Error("operasi Set mengubah operannya")
+	}
+	a.Remove(2)
+	a.Remove(9)
+	if a.Has(2) || a.Len() != 2 {
+		t.Errorf("setelah Remove(2): %v", SortedItems(a))
+	}
+}
+
+func TestOrderedMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 4) // an update keeps the place of "b"
+	if got := m.Keys(); !slices.Equal(got, []string{"b", "a", "c"}) {
+		t.Errorf("Keys = %v, mau [b a c]", got)
+	}
+	if got := m.Values(); !slices.Equal(got, []int{4, 2, 3}) {
+		t.Errorf("Values = %v, mau [4 2 3]", got)
+	}
+	if got := m.String(); got != "{b:4 a:2 c:3}" {
+		t.Errorf("String = %q", got)
+	}
+	if !m.Delete("a") || m.Delete("a") {
+		t.Error("Delete mengembalikan nilai yang salah")
+	}
+	if _, ok := m.Get("a"); ok || m.Len() != 2 {
+		t.Errorf("setelah Delete: Len %d, Get(a) ok %v", m.Len(), ok)
+	}
+	m.Set("a", 5)
+	if got := m.Keys(); !slices.Equal(got, []string{"b", "c", "a"}) {
+		t.Errorf("kunci yang disisip ulang harus di akhir: %v", got)
+	}
+	keys := m.Keys()
+	keys[0] = "x"
+	if m.Keys()[0] != "b" {
+		t.Error("Keys mengembalikan slice internal")
+	}
+}
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	if _, ok := s.Pop(); ok {
+		t.Error("Pop pada stack kosong ok = true")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Error("Peek pada stack kosong ok = true")
+	}
+	for i := range 3 {
+		s.Push(i)
+	}
+	if top, _ := s.Peek(); top != 2 || s.Len() != 3 {
+		t.Errorf("Peek = %d dengan Len %d, mau 2 dengan 3", top, s.Len())
+	}
+	var got []int
+	for s.Len() > 0 {
+		v, _ := s.Pop()
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{2, 1, 0}) {
+		t.Errorf("urutan Pop %v, mau [2 1 0]", got)
+	}
+}
+
+func TestQueueOrder(t *testing.T) {
+	var q Queue[int]
+	var got []int
+	// Interleave so that the head index wraps past the compaction point.
+	for i := range 100 {
+		q.Enqueue(i)
+		if i%3 == 2 {
+			v, _ := q.Dequeue()
+			got = append(got, v)
+		}
+	}
+	for q.Len() > 0 {
+		v, _ := q.Dequeue()
+		got = append(got, v)
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("urutan Queue %v, mau 0..99", got)
+		}
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Error("Dequeue pada antrean kosong ok = true")
+	}
+}
+
+func TestPriorityQueueOrder(t *testing.T) {
+	f := func(values []int) bool {
+		pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+		for _, v := range values {
+			pq.Push(v)
+		}
+		var got []int
+		for pq.Len() > 0 {
+			v, _ := pq.Pop()
+			got = append(got, v)
+		}
+		want := slices.Clone(values)
+		slices.Sort(want)
+		return slices.Equal(got, want)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+
+	pq := NewPriorityQueue(func(a, b string) bool { return len(a) > len(b) })
+	for _, s := range []string{"b", "ccc", "dd"} {
+		pq.Push(s)
+	}
+	if top, _ := pq.Peek(); top != "ccc" {
+		t.Errorf("Peek = %q, mau \"ccc\"", top)
+	}
+}
+
+func TestResultAndOption(t *testing.T) {
+	if got, err := Try(strconv.Atoi("12")).Get(); got != 12 || err != nil {
+		t.Errorf("Try(Atoi(12)) = %d, %v", got, err)
+	}
+	bad := Try(strconv.Atoi("dua"))
+	var numErr *strconv.NumError
+	if _, err := bad.Get(); bad.IsOk() || !errors.As(err, &numErr) {
+		t.Errorf("Try(Atoi(dua)) = %v, mau *strconv.NumError", bad)
+	}
+	if _, err := (Result[int]{}).Get(); !errors.Is(err, ErrEmptyResult) {
+		t.Errorf("Result kosong: %v, mau ErrEmptyResult", err)
+	}
+	if got := Ok(3).String() + " " + Fail[int](errors.New("x")).String(); got != "Ok(3) Fail(x)" {
+		t.Errorf("String = %q", got)
+	}
+
+	if v, ok := Some(0).Get(); v != 0 || !ok {
+		t.Errorf("Some(0).Get() = %d, %v; mau 0, true", v, ok)
+	}
+	if None[int]().IsSome() || None[int]().OrElse(7) != 7 || Some(1).OrElse(7) != 1 {
+		t.Error("OrElse/IsSome salah")
+	}
+	if got := None[int]().String() + " " + Some("a").String(); got != "None Some(a)" {
+		t.Errorf("String = %q", got)
+	}
+}
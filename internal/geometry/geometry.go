@@ -0,0 +1,253 @@
+// Package geometry is the generic 2D vector code behind main's Point
+// (float64) and Vertex (int): vectors, segments with intersection tests
+// and convex hulls. Operations that can leave the integer domain, like
+// Length or Rotate, always return float64 results.
+package geometry
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// Scalar is the element type of a Vec2: the signed integers and floats.
+type Scalar interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+type Vec2[T Scalar] struct {
+	X, Y T
+}
+
+type Segment[T Scalar] struct {
+	A, B Vec2[T]
+}
+
+func V[T Scalar](x, y T) Vec2[T] {
+	return Vec2[T]{x, y}
+}
+
+func (v Vec2[T]) Float() Vec2[float64] {
+	return Vec2[float64]{float64(v.X), float64(v.Y)}
+}
+
+func (v Vec2[T]) Add(o Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X + o.X, v.Y + o.Y}
+}
+
+func (v Vec2[T]) Sub(o Vec2[T]) Vec2[T] {
+	return Vec2[T]{v.X - o.X, v.Y - o.Y}
+}
+
+func (v Vec2[T]) Mul(k T) Vec2[T] {
+	return Vec2[T]{v.X * k, v.Y * k}
+}
+
+func (v Vec2[T]) Dot(o Vec2[T]) T {
+	return v.X*o.X + v.Y*o.Y
+}
+
+func (v Vec2[T]) Cross(o Vec2[T]) T {
+	return v.X*o.Y - v.Y*o.X
+}
+
+func (v Vec2[T]) Length() float64 {
+	return math.Hypot(float64(v.X), float64(v.Y))
+}
+
+func (v Vec2[T]) Distance(o Vec2[T]) float64 {
+	return math.Hypot(float64(v.X)-float64(o.X), float64(v.Y)-float64(o.Y))
+}
+
+func (v Vec2[T]) Normalize() (unit Vec2[float64], ok bool) {
+	f := v.Float()
+	length := f.Length()
+	if length == 0 || math.IsInf(length, 0) || math.IsNaN(length) {
+		return f, false
+	}
+	return Vec2[float64]{f.X / length, f.Y / length}, true
+}
+
+func (v Vec2[T]) Rotate(radians float64) Vec2[float64] {
+	sin, cos := math.Sincos(radians)
+	x, y := float64(v.X), float64(v.Y)
+	return Vec2[float64]{x*cos - y*sin, x*sin + y*cos}
+}
+
+func (v Vec2[T]) IsFinite() bool {
+	x, y := float64(v.X), float64(v.Y)
+	return !math.IsNaN(x) && !math.IsNaN(y) && !math.IsInf(x, 0) && !math.IsInf(y, 0)
+}
+
+func (v Vec2[T]) String() string {
+	return fmt.Sprintf("(%v, %v)", v.X, v.Y)
+}
+
+// IsFloat reports whether T is a floating-point type.
+func IsFloat[T Scalar]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+func (v Vec2[T]) CheckedAdd(o Vec2[T]) (sum Vec2[T], ok bool) {
+	sum = v.Add(o)
+	return sum, !AddOverflows(v.X, o.X, sum.X) && !AddOverflows(v.Y, o.Y, sum.Y)
+}
+
+func (v Vec2[T]) CheckedSub(o Vec2[T]) (diff Vec2[T], ok bool) {
+	diff = v.Sub(o)
+	return diff, !SubOverflows(v.X, o.X, diff.X) && !SubOverflows(v.Y, o.Y, diff.Y)
+}
+
+func (v Vec2[T]) CheckedDot(o Vec2[T]) (dot T, ok bool) {
+	a, okA := CheckedMul(v.X, o.X)
+	b, okB := CheckedMul(v.Y, o.Y)
+	dot = a + b
+	return dot, okA && okB && !AddOverflows(a, b, dot)
+}
+
+func (v Vec2[T]) CheckedCross(o Vec2[T]) (cross T, ok bool) {
+	a, okA := CheckedMul(v.X, o.Y)
+	b, okB := CheckedMul(v.Y, o.X)
+	cross = a - b
+	return cross, okA && okB && !SubOverflows(a, b, cross)
+}
+
+// AddOverflows reports whether sum = a + b wrapped around. It is always
+// false for floats.
+func AddOverflows[T Scalar](a, b, sum T) bool {
+	if IsFloat[T]() {
+		return false
+	}
+	return (a > 0 && b > 0 && sum < 0) || (a < 0 && b < 0 && sum >= 0)
+}
+
+func SubOverflows[T Scalar](a, b, diff T) bool {
+	if IsFloat[T]() {
+		return false
+	}
+	return (a >= 0 && b < 0 && diff < 0) || (a < 0 && b > 0 && diff >= 0)
+}
+
+// CheckedMul returns a * b and whether the integer product is exact.
+func CheckedMul[T Scalar](a, b T) (T, bool) {
+	product := a * b
+	if IsFloat[T]() || a == 0 || b == 0 {
+		return product, true
+	}
+	var minusOne T
+	minusOne--
+	// MinInt * -1 wraps to MinInt, which the division check cannot see.
+	if (a == minusOne && b < 0 && -b < 0) || (b == minusOne && a < 0 && -a < 0) {
+		return product, false
+	}
+	return product, product/b == a
+}
+
+func (s Segment[T]) Length() float64 {
+	return s.A.Distance(s.B)
+}
+
+func (s Segment[T]) Midpoint() Vec2[float64] {
+	a, b := s.A.Float(), s.B.Float()
+	return Vec2[float64]{(a.X + b.X) / 2, (a.Y + b.Y) / 2}
+}
+
+func orientation[T Scalar](a, b, c Vec2[T]) int {
+	ab, okB := b.CheckedSub(a)
+	ac, okC := c.CheckedSub(a)
+	if okB && okC {
+		if cross, ok := ab.CheckedCross(ac); ok {
+			return cmp.Compare(cross, 0)
+		}
+	}
+	return exactOrientation(a, b, c)
+}
+
+func exactOrientation[T Scalar](a, b, c Vec2[T]) int {
+	coord := func(x T) *big.Int {
+		return big.NewInt(int64(x))
+	}
+	abX := new(big.Int).Sub(coord(b.X), coord(a.X))
+	abY := new(big.Int).Sub(coord(b.Y), coord(a.Y))
+	acX := new(big.Int).Sub(coord(c.X), coord(a.X))
+	acY := new(big.Int).Sub(coord(c.Y), coord(a.Y))
+	left := new(big.Int).Mul(abX, acY)
+	right := new(big.Int).Mul(abY, acX)
+	return left.Cmp(right)
+}
+
+func onSegment[T Scalar](a, b, p Vec2[T]) bool {
+	return min(a.X, b.X) <= p.X && p.X <= max(a.X, b.X) &&
+		min(a.Y, b.Y) <= p.Y && p.Y <= max(a.Y, b.Y)
+}
+
+func (s Segment[T]) Intersects(o Segment[T]) bool {
+	o1 := orientation(s.A, s.B, o.A)
+	o2 := orientation(s.A, s.B, o.B)
+	o3 := orientation(o.A, o.B, s.A)
+	o4 := orientation(o.A, o.B, s.B)
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+	return (o1 == 0 && onSegment(s.A, s.B, o.A)) ||
+		(o2 == 0 && onSegment(s.A, s.B, o.B)) ||
+		(o3 == 0 && onSegment(o.A, o.B, s.A)) ||
+		(o4 == 0 && onSegment(o.A, o.B, s.B))
+}
+
+func (s Segment[T]) Intersection(o Segment[T]) (Vec2[float64], bool) {
+	p, r := s.A.Float(), s.B.Float().Sub(s.A.Float())
+	q, d := o.A.Float(), o.B.Float().Sub(o.A.Float())
+
+	denom := r.Cross(d)
+	if denom == 0 || math.IsNaN(denom) {
+		return Vec2[float64]{}, false
+	}
+	qp := q.Sub(p)
+	t := qp.Cross(d) / denom
+	u := qp.Cross(r) / denom
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return Vec2[float64]{}, false
+	}
+	return p.Add(r.Mul(t)), true
+}
+
+func ConvexHull[T Scalar](points []Vec2[T]) []Vec2[T] {
+	pts := make([]Vec2[T], 0, len(points))
+	for _, p := range points {
+		if p.IsFinite() {
+			pts = append(pts, p)
+		}
+	}
+	slices.SortFunc(pts, func(a, b Vec2[T]) int {
+		if c := cmp.Compare(a.X, b.X); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Y, b.Y)
+	})
+	pts = slices.Compact(pts)
+	if len(pts) < 3 {
+		return pts
+	}
+
+	hull := make([]Vec2[T], 0, 2*len(pts))
+	for _, p := range pts {
+		for len(hull) >= 2 && orientation(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(pts) - 2; i >= 0; i-- {
+		p := pts[i]
+		for len(hull) >= lower && orientation(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull[:len(hull)-1]
+}
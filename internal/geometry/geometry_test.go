@@ -0,0 +1,210 @@
+package geometry
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+// The properties run on full-range int64 coordinates, where almost every
+// cross product overflows, and on floats kept to a range whose products
+// stay finite.
+
+func bigFits(x *big.Int) bool {
+	return x.IsInt64()
+}
+
+func TestCheckedOpsReportOverflow(t *testing.T) {
+	add := func(a, b, c, d int64) bool {
+		sum, ok := V(a, b).CheckedAdd(V(c, d))
+		x := new(big.Int).Add(big.NewInt(a), big.NewInt(c))
+		y := new(big.Int).Add(big.NewInt(b), big.NewInt(d))
+		exact := bigFits(x) && bigFits(y)
+		return ok == exact && (!ok || sum == V(x.Int64(), y.Int64()))
+	}
+	sub := func(a, b, c, d int64) bool {
+		diff, ok := V(a, b).CheckedSub(V(c, d))
+		x := new(big.Int).Sub(big.NewInt(a), big.NewInt(c))
+		y := new(big.Int).Sub(big.NewInt(b), big.NewInt(d))
+		exact := bigFits(x) && bigFits(y)
+		return ok == exact && (!ok || diff == V(x.Int64(), y.Int64()))
+	}
+	cross := func(a, b, c, d int64) bool {
+		got, ok := V(a, b).CheckedCross(V(c, d))
+		l := new(big.Int).Mul(big.NewInt(a), big.NewInt(d))
+		r := new(big.Int).Mul(big.NewInt(b), big.NewInt(c))
+		want := new(big.Int).Sub(l, r)
+		// A product may overflow even when the difference would fit;
+		// reporting that is conservative but never silently wrong.
+		if ok {
+			return bigFits(want) && got == want.Int64()
+		}
+		return true
+	}
+	for name, f := range map[string]any{"CheckedAdd": add, "CheckedSub": sub, "CheckedCross": cross} {
+		if err := quick.Check(f, nil); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+
+	if _, ok := V[int64](math.MaxInt64, 0).CheckedAdd(V[int64](1, 0)); ok {
+		t.Error("MaxInt64 + 1 tidak dilaporkan overflow")
+	}
+	if _, ok := V[int64](math.MinInt64, 1).CheckedCross(V[int64](1, -1)); ok {
+		t.Error("MinInt64 × -1 tidak dilaporkan overflow")
+	}
+}
+
+func TestOrientationMatchesExact(t *testing.T) {
+	f := func(ax, ay, bx, by, cx, cy int64) bool {
+		a, b, c := V(ax, ay), V(bx, by), V(cx, cy)
+		return orientation(a, b, c) == exactOrientation(a, b, c)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIntersectsLargeCoordinates(t *testing.T) {
+	const m = 1 << 62
+	tests := []struct {
+		name string
+		s, o Segment[int64]
+		want bool
+	}{
+		// (b-a) is 2^63 in both components, which wraps an int64.
+		{"silang di titik nol", Segment[int64]{V[int64](-m, -m), V[int64](m, m)}, Segment[int64]{V[int64](-m, m), V[int64](m, -m)}, true},
+		{"sejajar bergeser 1", Segment[int64]{V[int64](-m, -m), V[int64](m, m)}, Segment[int64]{V[int64](-m, -m+1), V[int64](m, m+1)}, false},
+		{"ujung bersentuhan", Segment[int64]{V[int64](math.MinInt64, 0), V[int64](0, 0)}, Segment[int64]{V[int64](0, 0), V[int64](math.MaxInt64, math.MaxInt64)}, true},
+		{"kolinear terpisah", Segment[int64]{V[int64](math.MinInt64, math.MinInt64), V[int64](-1, -1)}, Segment[int64]{V[int64](1, 1), V[int64](math.MaxInt64, math.MaxInt64)}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.s.Intersects(tt.o); got != tt.want {
+			t.Errorf("%s: Intersects = %v, mau %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIntersectsSymmetric(t *testing.T) {
+	ints := func(s, o Segment[int64]) bool {
+		return s.Intersects(o) == o.Intersects(s) &&
+			s.Intersects(o) == Segment[int64]{s.B, s.A}.Intersects(o)
+	}
+	if err := quick.Check(ints, nil); err != nil {
+		t.Error("int64:", err)
+	}
+	small := func(s, o Segment[int8]) bool {
+		return s.Intersects(o) == o.Intersects(s)
+	}
+	if err := quick.Check(small, &quick.Config{MaxCount: 5000}); err != nil {
+		t.Error("int8:", err)
+	}
+	floats := func(s, o Segment[float64]) bool {
+		return s.Intersects(o) == o.Intersects(s)
+	}
+	if err := quick.Check(floats, nil); err != nil {
+		t.Error("float64:", err)
+	}
+}
+
+// hullContains checks, exactly, that p is on or to the left of every
+// edge of a counter-clockwise hull.
+func hullContains(hull []Vec2[int64], p Vec2[int64]) bool {
+	for i := range hull {
+		if exactOrientation(hull[i], hull[(i+1)%len(hull)], p) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestConvexHullContainsInputs(t *testing.T) {
+	f := func(points []Vec2[int64]) bool {
+		hull := ConvexHull(points)
+		if len(hull) < 3 {
+			return true
+		}
+		for _, h := range hull {
+			if !slices.Contains(points, h) {
+				return false
+			}
+		}
+		for _, p := range points {
+			if !hullContains(hull, p) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+
+	// A square whose sides are close to 2^64 long.
+	corners := []Vec2[int64]{
+		V[int64](math.MinInt64, math.MinInt64), V[int64](math.MaxInt64, math.MinInt64),
+		V[int64](math.MaxInt64, math.MaxInt64), V[int64](math.MinInt64, math.MaxInt64),
+		V[int64](0, 0), V[int64](1, -5),
+	}
+	if hull := ConvexHull(corners); len(hull) != 4 {
+		t.Errorf("hull persegi besar: %v, mau 4 sudut", hull)
+	}
+}
+
+func TestConvexHullFloatContainsInputs(t *testing.T) {
+	f := func(raw [][2]float64) bool {
+		points := make([]Vec2[float64], len(raw))
+		for i, r := range raw {
+			points[i] = V(math.Mod(r[0], 1e6), math.Mod(r[1], 1e6))
+		}
+		hull := ConvexHull(points)
+		if len(hull) < 3 {
+			return true
+		}
+		for _, p := range points {
+			for i := range hull {
+				a, b := hull[i], hull[(i+1)%len(hull)]
+				// Relative tolerance for points on an edge.
+				if b.Sub(a).Cross(p.Sub(a)) < -1e-6*b.Sub(a).Length()*(1+p.Sub(a).Length()) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNonFiniteInputsDoNotPanic(t *testing.T) {
+	special := []float64{0, 1, -1, math.NaN(), math.Inf(1), math.Inf(-1), math.MaxFloat64, -math.MaxFloat64}
+	var points []Vec2[float64]
+	for _, x := range special {
+		for _, y := range special {
+			points = append(points, V(x, y))
+		}
+	}
+	for _, a := range points {
+		for _, b := range points {
+			s := Segment[float64]{a, b}
+			o := Segment[float64]{b, V(1.0, 1.0)}
+			s.Intersects(o)
+			s.Intersection(o)
+			s.Length()
+			s.Midpoint()
+			a.Normalize()
+		}
+	}
+	hull := ConvexHull(points)
+	for _, p := range hull {
+		if !p.IsFinite() {
+			t.Errorf("hull memuat titik tak hingga %v", p)
+		}
+	}
+	if _, ok := V(math.NaN(), 0).Normalize(); ok {
+		t.Error("Normalize(NaN) ok = true")
+	}
+}
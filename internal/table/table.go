@@ -0,0 +1,1265 @@
+// Package table holds typed, ordered tables with a chained query API, a
+// small SQL dialect on top of it and CSV, JSON and Markdown output. The
+// query command and the sample cities data live in package main.
+package table
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Table is the next step after the populations map in mapExample (package main): rows
+// keep their order, every column has a type, and queries return their
+// results in a predictable order instead of map iteration order.
+//
+// A cell holds nil (missing), string, int64, float64 or bool, matching the
+// column type.
+type Table struct {
+	Columns []Column
+	Rows    [][]any
+}
+
+type ColumnType int
+
+const (
+	StringColumn ColumnType = iota
+	IntColumn
+	FloatColumn
+	BoolColumn
+)
+
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+type Condition struct {
+	Column string
+	Op     string // =, !=, <, <=, >, >=
+	Value  any
+}
+
+type Aggregate struct {
+	Func   string // count, sum, avg, min, max
+	Column string // empty for count(*)
+	Alias  string
+}
+
+type OrderKey struct {
+	Column string
+	Desc   bool
+}
+
+// Query is built with chained calls and executed by Run. Steps always run
+// in SQL order — Where, GroupBy/Aggregate, OrderBy, Limit, Select — no
+// matter in which order they were chained. The first error is kept and
+// returned by Run.
+type Query struct {
+	table   *Table
+	conds   []Condition
+	groupBy []string
+	aggs    []Aggregate
+	order   []OrderKey
+	selects []string
+	limit   int
+	err     error
+}
+
+// SQLQuery is the parsed form of the small SQL dialect accepted by
+// ParseSQL:
+//
+//	SELECT item, ... [FROM name] [WHERE cond AND ...]
+//	       [GROUP BY col, ...] [ORDER BY col [ASC|DESC], ...] [LIMIT n]
+//
+// where an item is *, a column or COUNT/SUM/AVG/MIN/MAX(col) with an
+// optional AS alias, and a condition compares a column to a literal.
+type SQLQuery struct {
+	From    string
+	Items   []string
+	Aggs    []Aggregate
+	Conds   []Condition
+	GroupBy []string
+	Order   []OrderKey
+	Limit   int
+}
+
+var (
+	ErrUnknownColumn = errors.New("kolom tidak dikenal")
+	ErrSQLSyntax     = errors.New("sintaks query salah")
+	ErrSumOverflow   = errors.New("jumlah melebihi batas int64")
+)
+
+func (t ColumnType) String() string {
+	switch t {
+	case IntColumn:
+		return "int"
+	case FloatColumn:
+		return "float"
+	case BoolColumn:
+		return "bool"
+	}
+	return "string"
+}
+
+func NewTable(columns ...Column) *Table {
+	return &Table{Columns: columns}
+}
+
+func (t *Table) ColumnIndex(name string) (int, error) {
+	for i, c := range t.Columns {
+		if strings.EqualFold(c.Name, name) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("%w: %q", ErrUnknownColumn, name)
+}
+
+// AddRow appends one row, converting each value to its column type.
+func (t *Table) AddRow(values ...any) error {
+	if len(values) != len(t.Columns) {
+		return fmt.Errorf("baris memiliki %d nilai, tabel memiliki %d kolom", len(values), len(t.Columns))
+	}
+	row := make([]any, len(values))
+	for i, v := range values {
+		cell, err := convertCell(v, t.Columns[i].Type)
+		if err != nil {
+			return fmt.Errorf("kolom %q: %w", t.Columns[i].Name, err)
+		}
+		row[i] = cell
+	}
+	t.Rows = append(t.Rows, row)
+	return nil
+}
+
+func convertCell(v any, typ ColumnType) (any, error) {
+	switch x := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return parseCell(x, typ)
+	case json.Number:
+		return parseCell(x.String(), typ)
+	case int:
+		v = int64(x)
+	case float32:
+		v = float64(x)
+	}
+
+	switch x := v.(type) {
+	case int64:
+		switch typ {
+		case IntColumn:
+			return x, nil
+		case FloatColumn:
+			return float64(x), nil
+		}
+	case float64:
+		if typ == FloatColumn {
+			return x, nil
+		}
+		if typ == IntColumn && x == float64(int64(x)) {
+			return int64(x), nil
+		}
+	case bool:
+		if typ == BoolColumn {
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("nilai %v (%T) tidak cocok dengan tipe %s", v, v, typ)
+}
+
+func parseCell(s string, typ ColumnType) (any, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	switch typ {
+	case IntColumn:
+		n, err := strconv.ParseInt(strings.ReplaceAll(s, "_", ""), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bukan bilangan bulat: %q", s)
+		}
+		return n, nil
+	case FloatColumn:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bukan angka: %q", s)
+		}
+		return f, nil
+	case BoolColumn:
+		switch strings.ToLower(s) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("bukan boolean: %q", s)
+	}
+	return s, nil
+}
+
+// inferColumnType picks the narrowest type that fits every non-empty
+// value: int, then float, then bool, falling back to string.
+func inferColumnType(values []string) ColumnType {
+	for _, typ := range []ColumnType{IntColumn, FloatColumn, BoolColumn} {
+		fits, seen := true, false
+		for _, v := range values {
+			if strings.TrimSpace(v) == "" {
+				continue
+			}
+			seen = true
+			if _, err := parseCell(v, typ); err != nil {
+				fits = false
+				break
+			}
+		}
+		if fits && seen {
+			return typ
+		}
+	}
+	return StringColumn
+}
+
+// LoadTableCSV reads a header row followed by data rows and infers the
+// type of every column from its values.
+func LoadTableCSV(r io.Reader) (*Table, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("csv: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("csv: file kosong, header tidak ditemukan")
+	}
+
+	header, data := records[0], records[1:]
+	t := NewTable()
+	for i, name := range header {
+		values := make([]string, len(data))
+		for r, record := range data {
+			values[r] = record[i]
+		}
+		t.Columns = append(t.Columns, Column{Name: strings.TrimSpace(name), Type: inferColumnType(values)})
+	}
+	// csv.Reader already rejects rows whose field count differs from the
+	// header, and every value parses because the type was inferred from it.
+	for _, record := range data {
+		row := make([]any, len(record))
+		for i, s := range record {
+			row[i], _ = parseCell(s, t.Columns[i].Type)
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t, nil
+}
+
+// LoadTableJSON reads an array of flat objects. Columns appear in the order
+// their keys are first seen, which is why the input is read token by token
+// instead of into maps.
+func LoadTableJSON(r io.Reader) (*Table, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, errors.New("json: data harus berupa array objek")
+	}
+
+	var names []string
+	var records []map[string]any
+	for dec.More() {
+		if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+			return nil, fmt.Errorf("json: elemen ke-%d bukan objek", len(records)+1)
+		}
+		record := map[string]any{}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("json: %w", err)
+			}
+			key := tok.(string)
+			var v any
+			if err := dec.Decode(&v); err != nil {
+				return nil, fmt.Errorf("json: %w", err)
+			}
+			if _, seen := record[key]; !seen && !slices.Contains(names, key) {
+				names = append(names, key)
+			}
+			record[key] = v
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, fmt.Errorf("json: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	t := NewTable()
+	for _, name := range names {
+		typ, err := inferJSONType(name, records)
+		if err != nil {
+			return nil, err
+		}
+		t.Columns = append(t.Columns, Column{Name: name, Type: typ})
+	}
+	for i, record := range records {
+		values := make([]any, len(names))
+		for j, name := range names {
+			values[j] = record[name]
+		}
+		if err := t.AddRow(values...); err != nil {
+			return nil, fmt.Errorf("json: elemen ke-%d: %w", i+1, err)
+		}
+	}
+	return t, nil
+}
+
+func inferJSONType(name string, records []map[string]any) (ColumnType, error) {
+	typ, seen := StringColumn, false
+	for _, record := range records {
+		var got ColumnType
+		switch v := record[name].(type) {
+		case nil:
+			continue
+		case string:
+			got = StringColumn
+		case bool:
+			got = BoolColumn
+		case json.Number:
+			got = IntColumn
+			if _, err := v.Int64(); err != nil {
+				got = FloatColumn
+			}
+		default:
+			return 0, fmt.Errorf("json: kolom %q berisi nilai bersarang", name)
+		}
+
+		switch {
+		case !seen:
+			typ, seen = got, true
+		case typ == got:
+		case typ == IntColumn && got == FloatColumn, typ == FloatColumn && got == IntColumn:
+			typ = FloatColumn
+		default:
+			return 0, fmt.Errorf("json: kolom %q berisi tipe campuran %s dan %s", name, typ, got)
+		}
+	}
+	return typ, nil
+}
+
+func LoadTableFile(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return LoadTableCSV(f)
+	case ".json":
+		return LoadTableJSON(f)
+	}
+	return nil, fmt.Errorf("format file %q tidak dikenal (gunakan .csv atau .json)", path)
+}
+
+// compareCells orders nil before everything else and compares ints and
+// floats numerically.
+func compareCells(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	ai, aInt := a.(int64)
+	bi, bInt := b.(int64)
+	if aInt && bInt {
+		return cmpOrdered(ai, bi)
+	}
+	af, aNum := cellFloat(a)
+	bf, bNum := cellFloat(b)
+	if aNum && bNum {
+		return cmpOrdered(af, bf)
+	}
+
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return cmpOrdered(a, b)
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0
+			case !a:
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(fmt.Sprintf("%T", a), fmt.Sprintf("%T", b))
+}
+
+func cmpOrdered[T int64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func cellFloat(v any) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case float64:
+		return x, true
+	}
+	return 0, false
+}
+
+func (t *Table) Query() *Query {
+	return &Query{table: t, limit: -1}
+}
+
+func (q *Query) Where(column, op string, value any) *Query {
+	q.conds = append(q.conds, Condition{Column: column, Op: op, Value: value})
+	return q
+}
+
+func (q *Query) GroupBy(columns ...string) *Query {
+	q.groupBy = append(q.groupBy, columns...)
+	return q
+}
+
+func (q *Query) Aggregate(aggs ...Aggregate) *Query {
+	q.aggs = append(q.aggs, aggs...)
+	return q
+}
+
+func (q *Query) OrderBy(column string) *Query {
+	q.order = append(q.order, OrderKey{Column: column})
+	return q
+}
+
+func (q *Query) OrderByDesc(column string) *Query {
+	q.order = append(q.order, OrderKey{Column: column, Desc: true})
+	return q
+}
+
+func (q *Query) Select(columns ...string) *Query {
+	q.selects = append(q.selects, columns...)
+	return q
+}
+
+func (q *Query) Limit(n int) *Query {
+	if n < 0 && q.err == nil {
+		q.err = fmt.Errorf("limit tidak boleh negatif: %d", n)
+	}
+	q.limit = n
+	return q
+}
+
+func AggCount() Aggregate {
+	return Aggregate{Func: "count"}
+}
+
+func AggSum(column string) Aggregate {
+	return Aggregate{Func: "sum", Column: column}
+}
+
+func AggAvg(column string) Aggregate {
+	return Aggregate{Func: "avg", Column: column}
+}
+
+func AggMin(column string) Aggregate {
+	return Aggregate{Func: "min", Column: column}
+}
+
+func AggMax(column string) Aggregate {
+	return Aggregate{Func: "max", Column: column}
+}
+
+func (a Aggregate) As(alias string) Aggregate {
+	a.Alias = alias
+	return a
+}
+
+// Name is the result column name: the alias, or e.g. "sum(population)".
+func (a Aggregate) Name() string {
+	if a.Alias != "" {
+		return a.Alias
+	}
+	column := a.Column
+	if column == "" {
+		column = "*"
+	}
+	return a.Func + "(" + column + ")"
+}
+
+func (q *Query) Run() (*Table, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	t, err := q.table.filter(q.conds)
+	if err != nil {
+		return nil, err
+	}
+	if len(q.groupBy) > 0 || len(q.aggs) > 0 {
+		if t, err = t.group(q.groupBy, q.aggs); err != nil {
+			return nil, err
+		}
+	}
+	if len(q.order) > 0 {
+		if err := t.sortBy(q.order); err != nil {
+			return nil, err
+		}
+	}
+	if q.limit >= 0 && q.limit < len(t.Rows) {
+		t.Rows = t.Rows[:q.limit]
+	}
+	if len(q.selects) > 0 && !(len(q.selects) == 1 && q.selects[0] == "*") {
+		return t.project(q.selects)
+	}
+	return t, nil
+}
+
+func (t *Table) filter(conds []Condition) (*Table, error) {
+	conds = append([]Condition(nil), conds...)
+	idx := make([]int, len(conds))
+	for i, c := range conds {
+		col, err := t.ColumnIndex(c.Column)
+		if err != nil {
+			return nil, err
+		}
+		value, err := convertCell(c.Value, t.Columns[col].Type)
+		if t.Columns[col].Type == IntColumn && err != nil {
+			// 1.5 cannot become an int, but "population > 1.5" still makes sense.
+			value, err = convertCell(c.Value, FloatColumn)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("kondisi %s %s %v: %w", c.Column, c.Op, c.Value, err)
+		}
+		switch c.Op {
+		case "=", "!=", "<", "<=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("operator %q tidak dikenal", c.Op)
+		}
+		idx[i], conds[i].Value = col, value
+	}
+
+	out := &Table{Columns: t.Columns}
+	for _, row := range t.Rows {
+		keep := true
+		for i, c := range conds {
+			// Like SQL NULL, a missing value never satisfies a condition.
+			if row[idx[i]] == nil || !compareOp(compareCells(row[idx[i]], c.Value), c.Op) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			out.Rows = append(out.Rows, row)
+		}
+	}
+	return out, nil
+}
+
+func compareOp(cmp int, op string) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	}
+	return cmp >= 0
+}
+
+type tableGroup struct {
+	key  []any
+	rows [][]any
+}
+
+// group returns one row per distinct combination of the groupBy columns,
+// ordered by those values, followed by one column per aggregate.
+func (t *Table) group(groupBy []string, aggs []Aggregate) (*Table, error) {
+	keyIdx := make([]int, len(groupBy))
+	out := NewTable()
+	for i, name := range groupBy {
+		col, err := t.ColumnIndex(name)
+		if err != nil {
+			return nil, err
+		}
+		keyIdx[i] = col
+		out.Columns = append(out.Columns, t.Columns[col])
+	}
+
+	aggIdx := make([]int, len(aggs))
+	for i, a := range aggs {
+		aggIdx[i] = -1
+		typ := IntColumn
+		if a.Column != "" && a.Column != "*" {
+			col, err := t.ColumnIndex(a.Column)
+			if err != nil {
+				return nil, err
+			}
+			aggIdx[i] = col
+			typ = t.Columns[col].Type
+		}
+		switch a.Func {
+		case "count":
+			typ = IntColumn
+		case "sum", "avg":
+			if aggIdx[i] < 0 || (typ != IntColumn && typ != FloatColumn) {
+				return nil, fmt.Errorf("%s membutuhkan kolom angka", a.Name())
+			}
+			if a.Func == "avg" {
+				typ = FloatColumn
+			}
+		case "min", "max":
+			if aggIdx[i] < 0 {
+				return nil, fmt.Errorf("%s membutuhkan nama kolom", a.Name())
+			}
+		default:
+			return nil, fmt.Errorf("fungsi agregat %q tidak dikenal", a.Func)
+		}
+		out.Columns = append(out.Columns, Column{Name: a.Name(), Type: typ})
+	}
+
+	groups := map[string]*tableGroup{}
+	var order []*tableGroup
+	for _, row := range t.Rows {
+		key := make([]any, len(keyIdx))
+		for i, col := range keyIdx {
+			key[i] = row[col]
+		}
+		k := fmt.Sprintf("%#v", key)
+		g, ok := groups[k]
+		if !ok {
+			g = &tableGroup{key: key}
+			groups[k] = g
+			order = append(order, g)
+		}
+		g.rows = append(g.rows, row)
+	}
+	// An aggregate over no rows at all still produces one row, like SQL.
+	if len(groupBy) == 0 && len(order) == 0 {
+		order = append(order, &tableGroup{})
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		for k := range order[i].key {
+			if c := compareCells(order[i].key[k], order[j].key[k]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	for _, g := range order {
+		row := append([]any(nil), g.key...)
+		for i, a := range aggs {
+			v, err := aggregateRows(a.Func, aggIdx[i], out.Columns[len(groupBy)+i].Type, g.rows)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", a.Name(), err)
+			}
+			row = append(row, v)
+		}
+		out.Rows = append(out.Rows, row)
+	}
+	return out, nil
+}
+
+// aggregateRows computes fn over one group. SUM of an int column stays an
+// int64 and returns ErrSumOverflow rather than wrapping around.
+func aggregateRows(fn string, col int, typ ColumnType, rows [][]any) (any, error) {
+	var values []any
+	for _, row := range rows {
+		if col < 0 {
+			values = append(values, true)
+		} else if row[col] != nil {
+			values = append(values, row[col])
+		}
+	}
+
+	switch fn {
+	case "count":
+		return int64(len(values)), nil
+	case "min", "max":
+		var best any
+		for _, v := range values {
+			c := compareCells(v, best)
+			if best == nil || (fn == "min" && c < 0) || (fn == "max" && c > 0) {
+				best = v
+			}
+		}
+		return best, nil
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+	var sumInt int64
+	var sumFloat float64
+	for _, v := range values {
+		f, _ := cellFloat(v)
+		sumFloat += f
+		if i, ok := v.(int64); ok && fn == "sum" && typ == IntColumn {
+			if (i > 0 && sumInt > math.MaxInt64-i) || (i < 0 && sumInt < math.MinInt64-i) {
+				return nil, ErrSumOverflow
+			}
+			sumInt += i
+		}
+	}
+	switch {
+	case fn == "avg":
+		return sumFloat / float64(len(values)), nil
+	case typ == IntColumn:
+		return sumInt, nil
+	}
+	return sumFloat, nil
+}
+
+func (t *Table) sortBy(keys []OrderKey) error {
+	idx := make([]int, len(keys))
+	for i, k := range keys {
+		col, err := t.ColumnIndex(k.Column)
+		if err != nil {
+			return err
+		}
+		idx[i] = col
+	}
+	// Stable, so rows that compare equal keep their input order.
+	sort.SliceStable(t.Rows, func(i, j int) bool {
+		for k, key := range keys {
+			c := compareCells(t.Rows[i][idx[k]], t.Rows[j][idx[k]])
+			if key.Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	return nil
+}
+
+func (t *Table) project(columns []string) (*Table, error) {
+	idx := make([]int, len(columns))
+	out := NewTable()
+	for i, name := range columns {
+		col, err := t.ColumnIndex(name)
+		if err != nil {
+			return nil, err
+		}
+		idx[i] = col
+		out.Columns = append(out.Columns, t.Columns[col])
+	}
+	for _, row := range t.Rows {
+		projected := make([]any, len(idx))
+		for i, col := range idx {
+			projected[i] = row[col]
+		}
+		out.Rows = append(out.Rows, projected)
+	}
+	return out, nil
+}
+
+func formatCell(v any, forDisplay bool) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		if forDisplay {
+			return strconv.FormatFloat(x, 'f', 2, 64)
+		}
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// Format writes an aligned text table; numbers are right-aligned.
+func (t *Table) Format(w io.Writer) error {
+	widths := make([]int, len(t.Columns))
+	for i, c := range t.Columns {
+		widths[i] = len([]rune(c.Name))
+	}
+	cells := make([][]string, len(t.Rows))
+	for r, row := range t.Rows {
+		cells[r] = make([]string, len(row))
+		for i, v := range row {
+			cells[r][i] = formatCell(v, true)
+			widths[i] = max(widths[i], len([]rune(cells[r][i])))
+		}
+	}
+
+	var buf bytes.Buffer
+	line := func(values []string) {
+		for i, v := range values {
+			if i > 0 {
+				buf.WriteString("  ")
+			}
+			pad := strings.Repeat(" ", widths[i]-len([]rune(v)))
+			if t.Columns[i].Type == IntColumn || t.Columns[i].Type == FloatColumn {
+				buf.WriteString(pad + v)
+			} else if i < len(values)-1 {
+				buf.WriteString(v + pad)
+			} else {
+				buf.WriteString(v)
+			}
+		}
+		buf.WriteByte('\n')
+	}
+
+	names := make([]string, len(t.Columns))
+	rules := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = c.Name
+		rules[i] = strings.Repeat("-", widths[i])
+	}
+	line(names)
+	line(rules)
+	for _, row := range cells {
+		line(row)
+	}
+	fmt.Fprintf(&buf, "(%d baris)\n", len(t.Rows))
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (t *Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	names := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = c.Name
+	}
+	cw.Write(names)
+	for _, row := range t.Rows {
+		record := make([]string, len(row))
+		for i, v := range row {
+			record[i] = formatCell(v, false)
+		}
+		cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteMarkdown writes the table in the pipe syntax of GitHub-flavoured
+// markdown, with numeric columns right-aligned.
+func (t *Table) WriteMarkdown(w io.Writer) error {
+	var buf bytes.Buffer
+	line := func(values []string) {
+		buf.WriteString("|")
+		for _, v := range values {
+			buf.WriteString(" " + strings.ReplaceAll(v, "|", `\|`) + " |")
+		}
+		buf.WriteByte('\n')
+	}
+
+	names := make([]string, len(t.Columns))
+	rules := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = c.Name
+		rules[i] = "---"
+		if c.Type == IntColumn || c.Type == FloatColumn {
+			rules[i] = "---:"
+		}
+	}
+	line(names)
+	line(rules)
+	for _, row := range t.Rows {
+		values := make([]string, len(row))
+		for i, v := range row {
+			values[i] = formatCell(v, true)
+		}
+		line(values)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteJSON writes an array of objects whose keys follow the column order.
+func (t *Table) WriteJSON(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("[\n")
+	for r, row := range t.Rows {
+		buf.WriteString("  {")
+		for i, v := range row {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			key, _ := json.Marshal(t.Columns[i].Name)
+			value, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			buf.Write(key)
+			buf.WriteString(": ")
+			buf.Write(value)
+		}
+		buf.WriteString("}")
+		if r < len(t.Rows)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("]\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+type sqlToken struct {
+	text   string
+	quoted bool // a 'string literal'
+	pos    int
+}
+
+func tokenizeSQL(src string) ([]sqlToken, error) {
+	var tokens []sqlToken
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'':
+			var sb strings.Builder
+			start := i
+			for i++; ; i++ {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("%w: string di kolom %d tidak ditutup", ErrSQLSyntax, start+1)
+				}
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						sb.WriteRune('\'')
+						i++
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+			}
+			tokens = append(tokens, sqlToken{sb.String(), true, start})
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' ||
+			(r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i++; i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '.'); i++ {
+			}
+			tokens = append(tokens, sqlToken{string(runes[start:i]), false, start})
+		case strings.ContainsRune("<>!", r) && i+1 < len(runes) && (runes[i+1] == '=' || (r == '<' && runes[i+1] == '>')):
+			tokens = append(tokens, sqlToken{string(runes[i : i+2]), false, i})
+			i += 2
+		case strings.ContainsRune(",()*=<>", r):
+			tokens = append(tokens, sqlToken{string(r), false, i})
+			i++
+		default:
+			return nil, fmt.Errorf("%w: karakter %q di kolom %d", ErrSQLSyntax, r, i+1)
+		}
+	}
+	return tokens, nil
+}
+
+type sqlParser struct {
+	tokens []sqlToken
+	pos    int
+}
+
+func (p *sqlParser) peekKeyword(words ...string) bool {
+	for i, w := range words {
+		if p.pos+i >= len(p.tokens) {
+			return false
+		}
+		t := p.tokens[p.pos+i]
+		if t.quoted || !strings.EqualFold(t.text, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *sqlParser) acceptKeyword(words ...string) bool {
+	if p.peekKeyword(words...) {
+		p.pos += len(words)
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) next(what string) (sqlToken, error) {
+	if p.pos >= len(p.tokens) {
+		return sqlToken{}, fmt.Errorf("%w: query berakhir, diharapkan %s", ErrSQLSyntax, what)
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *sqlParser) expect(text string) error {
+	t, err := p.next(fmt.Sprintf("%q", text))
+	if err != nil {
+		return err
+	}
+	if t.quoted || !strings.EqualFold(t.text, text) {
+		return fmt.Errorf("%w: diharapkan %q di kolom %d, ditemukan %q", ErrSQLSyntax, text, t.pos+1, t.text)
+	}
+	return nil
+}
+
+func (p *sqlParser) identifier() (string, error) {
+	t, err := p.next("nama kolom")
+	if err != nil {
+		return "", err
+	}
+	if t.quoted || !isSQLIdentifier(t.text) {
+		return "", fmt.Errorf("%w: nama kolom tidak valid %q di kolom %d", ErrSQLSyntax, t.text, t.pos+1)
+	}
+	return t.text, nil
+}
+
+func isSQLIdentifier(s string) bool {
+	for i, r := range s {
+		if !(unicode.IsLetter(r) || r == '_' || (i > 0 && unicode.IsDigit(r))) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func ParseSQL(src string) (*SQLQuery, error) {
+	tokens, err := tokenizeSQL(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &sqlParser{tokens: tokens}
+	q := &SQLQuery{Limit: -1}
+
+	if err := p.expect("SELECT"); err != nil {
+		return nil, err
+	}
+	for {
+		if err := p.selectItem(q); err != nil {
+			return nil, err
+		}
+		if !p.acceptKeyword(",") {
+			break
+		}
+	}
+
+	if p.acceptKeyword("FROM") {
+		t, err := p.next("nama tabel")
+		if err != nil {
+			return nil, err
+		}
+		q.From = t.text
+	}
+	if p.acceptKeyword("WHERE") {
+		for {
+			c, err := p.condition()
+			if err != nil {
+				return nil, err
+			}
+			q.Conds = append(q.Conds, c)
+			if !p.acceptKeyword("AND") {
+				break
+			}
+		}
+	}
+	if p.acceptKeyword("GROUP", "BY") {
+		for {
+			name, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			q.GroupBy = append(q.GroupBy, name)
+			if !p.acceptKeyword(",") {
+				break
+			}
+		}
+	}
+	if p.acceptKeyword("ORDER", "BY") {
+		for {
+			name, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			key := OrderKey{Column: name}
+			if p.acceptKeyword("DESC") {
+				key.Desc = true
+			} else {
+				p.acceptKeyword("ASC")
+			}
+			q.Order = append(q.Order, key)
+			if !p.acceptKeyword(",") {
+				break
+			}
+		}
+	}
+	if p.acceptKeyword("LIMIT") {
+		t, err := p.next("angka")
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(t.text)
+		if err != nil || n < 0 || t.quoted {
+			return nil, fmt.Errorf("%w: LIMIT harus bilangan bulat positif, bukan %q", ErrSQLSyntax, t.text)
+		}
+		q.Limit = n
+	}
+	if p.pos < len(p.tokens) {
+		t := p.tokens[p.pos]
+		return nil, fmt.Errorf("%w: token tidak terduga %q di kolom %d", ErrSQLSyntax, t.text, t.pos+1)
+	}
+
+	if len(q.Aggs) > 0 || len(q.GroupBy) > 0 {
+		for _, item := range q.Items {
+			if item == "*" {
+				return nil, fmt.Errorf("%w: SELECT * tidak bisa dipakai bersama GROUP BY atau agregat", ErrSQLSyntax)
+			}
+			if !isAggregateItem(item, q.Aggs) && !containsFold(q.GroupBy, item) {
+				return nil, fmt.Errorf("%w: kolom %q harus ada di GROUP BY atau dipakai dalam agregat", ErrSQLSyntax, item)
+			}
+		}
+	}
+	return q, nil
+}
+
+func isAggregateItem(item string, aggs []Aggregate) bool {
+	for _, a := range aggs {
+		if a.Name() == item {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *sqlParser) selectItem(q *SQLQuery) error {
+	if p.acceptKeyword("*") {
+		q.Items = append(q.Items, "*")
+		return nil
+	}
+	name, err := p.identifier()
+	if err != nil {
+		return err
+	}
+
+	fn := strings.ToLower(name)
+	switch fn {
+	case "count", "sum", "avg", "min", "max":
+		if !p.peekKeyword("(") {
+			break
+		}
+		p.pos++
+		agg := Aggregate{Func: fn}
+		if !p.acceptKeyword("*") {
+			if agg.Column, err = p.identifier(); err != nil {
+				return err
+			}
+		} else if fn != "count" {
+			return fmt.Errorf("%w: %s(*) tidak didukung", ErrSQLSyntax, strings.ToUpper(fn))
+		}
+		if err := p.expect(")"); err != nil {
+			return err
+		}
+		if p.acceptKeyword("AS") {
+			if agg.Alias, err = p.identifier(); err != nil {
+				return err
+			}
+		}
+		q.Aggs = append(q.Aggs, agg)
+		q.Items = append(q.Items, agg.Name())
+		return nil
+	}
+
+	if p.acceptKeyword("AS") {
+		return fmt.Errorf("%w: alias hanya didukung untuk agregat", ErrSQLSyntax)
+	}
+	q.Items = append(q.Items, name)
+	return nil
+}
+
+func (p *sqlParser) condition() (Condition, error) {
+	column, err := p.identifier()
+	if err != nil {
+		return Condition{}, err
+	}
+	opTok, err := p.next("operator")
+	if err != nil {
+		return Condition{}, err
+	}
+	op := opTok.text
+	if op == "<>" {
+		op = "!="
+	}
+	switch op {
+	case "=", "!=", "<", "<=", ">", ">=":
+	default:
+		return Condition{}, fmt.Errorf("%w: operator tidak dikenal %q di kolom %d", ErrSQLSyntax, opTok.text, opTok.pos+1)
+	}
+
+	lit, err := p.next("nilai")
+	if err != nil {
+		return Condition{}, err
+	}
+	c := Condition{Column: column, Op: op}
+	switch {
+	case lit.quoted:
+		c.Value = lit.text
+	case strings.EqualFold(lit.text, "true"), strings.EqualFold(lit.text, "false"):
+		c.Value = strings.EqualFold(lit.text, "true")
+	default:
+		if n, err := strconv.ParseInt(lit.text, 10, 64); err == nil {
+			c.Value = n
+		} else if f, err := strconv.ParseFloat(lit.text, 64); err == nil {
+			c.Value = f
+		} else {
+			return Condition{}, fmt.Errorf("%w: nilai %q di kolom %d harus angka, boolean atau 'string'", ErrSQLSyntax, lit.text, lit.pos+1)
+		}
+	}
+	return c, nil
+}
+
+// On turns the parsed statement into a fluent Query over t; FROM is left
+// to the caller.
+func (s *SQLQuery) On(t *Table) *Query {
+	q := t.Query().GroupBy(s.GroupBy...).Aggregate(s.Aggs...).Select(s.Items...)
+	for _, c := range s.Conds {
+		q.Where(c.Column, c.Op, c.Value)
+	}
+	q.order = append(q.order, s.Order...)
+	if s.Limit >= 0 {
+		q.Limit(s.Limit)
+	}
+	return q
+}
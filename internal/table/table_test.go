@@ -0,0 +1,235 @@
+package table
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testTable(t *testing.T) *Table {
+	t.Helper()
+	tbl := NewTable(
+		Column{Name: "name", Type: StringColumn},
+		Column{Name: "island", Type: StringColumn},
+		Column{Name: "population", Type: IntColumn},
+		Column{Name: "area", Type: FloatColumn},
+	)
+	rows := [][]any{
+		{"Surabaya", "Jawa", 2874314, 350.5},
+		{"Denpasar", "Bali", 726800, 127.8},
+		{"Medan", "Sumatra", 2435252, 265.1},
+		{"Bandung", "Jawa", 2444160, 167.3},
+		{"O'Brien", nil, nil, 1.0},
+	}
+	for _, row := range rows {
+		if err := tbl.AddRow(row...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tbl
+}
+
+func runSQL(t *testing.T, tbl *Table, query string) (*Table, error) {
+	t.Helper()
+	stmt, err := ParseSQL(query)
+	if err != nil {
+		t.Fatalf("ParseSQL(%q): %v", query, err)
+	}
+	return stmt.On(tbl).Run()
+}
+
+func TestParseSQLErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"SELEC name",
+		"SELECT",
+		"SELECT name FROM",
+		"SELECT name WHERE island",
+		"SELECT name WHERE island ~ 'Jawa'",
+		"SELECT name WHERE island = 'Jawa",
+		"SELECT name WHERE population = banyak",
+		"SELECT name WHERE island LIKE 'J'",
+		"SELECT name LIMIT -1",
+		"SELECT name LIMIT '3'",
+		"SELECT name LIMIT 1 2",
+		"SELECT 1name",
+		"SELECT name AS n",
+		"SELECT SUM(*)",
+		"SELECT COUNT(*",
+		"SELECT *, COUNT(*)",
+		"SELECT name, COUNT(*) GROUP BY island",
+		"SELECT name ORDER BY",
+	}
+	for _, query := range tests {
+		if _, err := ParseSQL(query); !errors.Is(err, ErrSQLSyntax) {
+			t.Errorf("ParseSQL(%q) = %v, mau ErrSQLSyntax", query, err)
+		}
+	}
+}
+
+func TestParseSQLQuotedStrings(t *testing.T) {
+	stmt, err := ParseSQL("SELECT name FROM 'data kota.csv' WHERE name = 'O''Brien' AND island != ''")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stmt.From != "data kota.csv" {
+		t.Errorf("From = %q, mau \"data kota.csv\"", stmt.From)
+	}
+	want := []Condition{{"name", "=", "O'Brien"}, {"island", "!=", ""}}
+	if !reflect.DeepEqual(stmt.Conds, want) {
+		t.Errorf("Conds = %#v, mau %#v", stmt.Conds, want)
+	}
+
+	// Nilai kosong tidak sama dengan maupun berbeda dari string apa pun.
+	result, err := stmt.On(testTable(t)).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Rows) != 0 {
+		t.Errorf("hasil %v, mau kosong karena island O'Brien kosong", result.Rows)
+	}
+
+	result, err = runSQL(t, testTable(t), "SELECT name WHERE name = 'O''Brien'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Rows) != 1 || result.Rows[0][0] != "O'Brien" {
+		t.Errorf("hasil %v, mau satu baris O'Brien", result.Rows)
+	}
+}
+
+func TestGroupByOrder(t *testing.T) {
+	tbl := testTable(t)
+
+	// Tanpa ORDER BY grup diurutkan menurut kunci, dengan nilai kosong
+	// lebih dulu, bukan menurut urutan kemunculan.
+	result, err := runSQL(t, tbl, "SELECT island, COUNT(*) AS n, SUM(population) AS total GROUP BY island")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]any{
+		{nil, int64(1), nil},
+		{"Bali", int64(1), int64(726800)},
+		{"Jawa", int64(2), int64(5318474)},
+		{"Sumatra", int64(1), int64(2435252)},
+	}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("GROUP BY island =\n  %v\nmau\n  %v", result.Rows, want)
+	}
+
+	result, err = runSQL(t, tbl, "SELECT island, COUNT(*) AS n GROUP BY island ORDER BY n DESC, island DESC LIMIT 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = [][]any{{"Jawa", int64(2)}, {"Sumatra", int64(1)}}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("ORDER BY n DESC =\n  %v\nmau\n  %v", result.Rows, want)
+	}
+}
+
+func TestAggregatesOverEmptyInput(t *testing.T) {
+	tbl := testTable(t)
+	result, err := runSQL(t, tbl, "SELECT COUNT(*), COUNT(population), SUM(population), AVG(area), MIN(name), MAX(area) WHERE population > 99999999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]any{{int64(0), int64(0), nil, nil, nil, nil}}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("agregat tanpa baris = %v, mau %v", result.Rows, want)
+	}
+
+	result, err = runSQL(t, tbl, "SELECT island, COUNT(*) WHERE population > 99999999 GROUP BY island")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Rows) != 0 {
+		t.Errorf("GROUP BY tanpa baris = %v, mau kosong", result.Rows)
+	}
+
+	// COUNT(kolom) melewati nilai kosong, COUNT(*) tidak.
+	result, err = runSQL(t, tbl, "SELECT COUNT(*), COUNT(island), AVG(population)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := result.Rows[0][:2]; !reflect.DeepEqual(got, []any{int64(5), int64(4)}) {
+		t.Errorf("COUNT(*), COUNT(island) = %v, mau [5 4]", got)
+	}
+	if avg := result.Rows[0][2].(float64); math.Abs(avg-2120131.5) > 1e-6 {
+		t.Errorf("AVG(population) = %v, mau 2120131.5", avg)
+	}
+}
+
+func TestQueryTypeErrors(t *testing.T) {
+	tbl := testTable(t)
+	tests := []struct {
+		query string
+		want  error
+		msg   string
+	}{
+		{"SELECT SUM(name)", nil, "membutuhkan kolom angka"},
+		{"SELECT AVG(island) GROUP BY island", nil, "membutuhkan kolom angka"},
+		{"SELECT name WHERE population = 'banyak'", nil, "bukan angka"},
+		{"SELECT name WHERE name > 5", nil, "tidak cocok dengan tipe string"},
+		{"SELECT name WHERE area = true", nil, "tidak cocok dengan tipe float"},
+		{"SELECT mayor", ErrUnknownColumn, ""},
+		{"SELECT name WHERE mayor = 'x'", ErrUnknownColumn, ""},
+		{"SELECT name ORDER BY mayor", ErrUnknownColumn, ""},
+		{"SELECT MAX(mayor)", ErrUnknownColumn, ""},
+	}
+	for _, tt := range tests {
+		_, err := runSQL(t, tbl, tt.query)
+		if err == nil {
+			t.Errorf("%s: tidak menghasilkan error", tt.query)
+			continue
+		}
+		if tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: %v, mau %v", tt.query, err, tt.want)
+		}
+		if !strings.Contains(err.Error(), tt.msg) {
+			t.Errorf("%s: %v, mau berisi %q", tt.query, err, tt.msg)
+		}
+	}
+
+	// Angka pecahan tetap boleh dibandingkan dengan kolom int.
+	result, err := runSQL(t, tbl, "SELECT name WHERE population > 2444159.5 ORDER BY name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]any{{"Bandung"}, {"Surabaya"}}; !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("population > 2444159.5 = %v, mau %v", result.Rows, want)
+	}
+
+	if err := tbl.AddRow("Bogor", "Jawa", 1.5, 118.5); err == nil {
+		t.Error("AddRow menerima 1.5 untuk kolom int")
+	}
+	if err := tbl.AddRow("Bogor", "Jawa"); err == nil {
+		t.Error("AddRow menerima baris yang kurang kolom")
+	}
+}
+
+func TestSumOverflow(t *testing.T) {
+	tbl := NewTable(Column{Name: "g", Type: StringColumn}, Column{Name: "n", Type: IntColumn})
+	for _, row := range [][]any{{"a", int64(math.MaxInt64)}, {"a", 1}, {"b", int64(math.MinInt64)}, {"b", 5}} {
+		if err := tbl.AddRow(row...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := tbl.Query().GroupBy("g").Aggregate(AggSum("n").As("total")).Run()
+	if !errors.Is(err, ErrSumOverflow) || !strings.Contains(err.Error(), "total") {
+		t.Errorf("SUM yang meluap = %v, mau ErrSumOverflow untuk total", err)
+	}
+
+	// Grup b tidak meluap, dan AVG menghitung dengan float64.
+	result, err := tbl.Query().Where("g", "=", "b").Aggregate(AggSum("n"), AggAvg("n")).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := result.Rows[0][0]; got != int64(math.MinInt64+5) {
+		t.Errorf("SUM grup b = %v, mau %d", got, int64(math.MinInt64+5))
+	}
+	if _, err := tbl.Query().Aggregate(AggAvg("n")).Run(); err != nil {
+		t.Errorf("AVG yang jumlahnya melebihi int64 = %v, mau tanpa error", err)
+	}
+}
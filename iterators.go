@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Range-over-func iterators (Go 1.23+). An iter.Seq is a function that
+// calls yield once per element and stops as soon as yield returns false,
+// which is what a `break` in the range loop turns into. The adapters below
+// are lazy: nothing is computed until the loop asks for the next element.
+// They carry a Seq prefix because Map, Filter and friends already name the
+// slice versions in generics.go.
+
+func SeqMap[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+func SeqFilter[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqTake stops seq after n elements, so it is safe on infinite sequences.
+func SeqTake[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i == n {
+				return
+			}
+		}
+	}
+}
+
+// SeqZip pairs up a and b and ends with the shorter of the two. Two
+// sequences cannot be ranged over in lockstep, so b is turned into a
+// next/stop pair with iter.Pull; the deferred stop releases b whether a
+// runs out, b runs out or the caller breaks.
+func SeqZip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := next()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// SeqChunk groups seq into slices of size elements; the last one may be
+// shorter. Every chunk is a fresh slice, so callers may keep them.
+func SeqChunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("SeqChunk: size harus minimal 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) < size {
+				continue
+			}
+			if !yield(chunk) {
+				return
+			}
+			chunk = make([]T, 0, size)
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// SeqOfType keeps the elements of seq whose dynamic type is T, e.g. the
+// circles in a sequence of shapes.
+func SeqOfType[T, S any](seq iter.Seq[S]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			t, ok := any(v).(T)
+			if ok && !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Naturals yields 1, 2, 3, ... for as long as the loop keeps asking.
+func Naturals() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for n := 1; yield(n); n++ {
+		}
+	}
+}
+
+// Areas yields every shape together with its area.
+func (l ShapeList) Areas() iter.Seq2[Shape, float64] {
+	return func(yield func(Shape, float64) bool) {
+		for _, s := range l {
+			if !yield(s, s.Area()) {
+				return
+			}
+		}
+	}
+}
+
+// maxLineSize is the longest line ReadLines yields, well above
+// bufio.Scanner's 64 KiB default so a minified JSON or CSV line fits.
+const maxLineSize = 1 << 20
+
+// ReadLines opens a reader only when the loop starts and closes it when
+// the loop ends, however it ends: running out of lines, a break, a panic
+// in the loop body or the stop function of iter.Pull. That is the defer
+// below; the yield call returns false (or panics) and the iterator
+// function returns. A failure to open or read is yielded as the error of
+// the last pair; a line longer than maxLineSize ends the sequence with
+// bufio.ErrTooLong.
+func ReadLines(open func() (io.ReadCloser, error)) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		rc, err := open()
+		if err != nil {
+			yield("", err)
+			return
+		}
+		defer rc.Close()
+		sc := bufio.NewScanner(rc)
+		sc.Buffer(nil, maxLineSize)
+		for sc.Scan() {
+			if !yield(sc.Text(), nil) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			yield("", err)
+		}
+	}
+}
+
+// trackedReader records whether it has been closed, so the lessons can
+// show that an iterator cleaned up after itself.
+type trackedReader struct {
+	io.Reader
+	name   string
+	opened int
+	closed int
+}
+
+func (r *trackedReader) open(text string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		r.Reader = strings.NewReader(text)
+		r.opened++
+		return r, nil
+	}
+}
+
+func (r *trackedReader) Close() error {
+	r.closed++
+	return nil
+}
+
+func (r *trackedReader) String() string {
+	return fmt.Sprintf("%s: dibuka %d kali, ditutup %d kali, bocor: %t", r.name, r.opened, r.closed, r.opened != r.closed)
+}
+
+func iteratorExample() {
+	// Naturals never ends; the pipeline only pulls as many numbers as
+	// SeqTake lets through.
+	produced := 0
+	counted := SeqMap(Naturals(), func(n int) int {
+		produced++
+		return n
+	})
+	evens := SeqFilter(counted, func(n int) bool { return n%2 == 0 })
+	squares := SeqMap(evens, func(n int) int { return n * n })
+	logln("5 kuadrat genap pertama:", slices.Collect(SeqTake(squares, 5)))
+	logln("Bilangan yang dihasilkan Naturals:", produced)
+
+	dir, err := LoadDirectoryCSV(strings.NewReader(sampleEmployeesCSV))
+	if err != nil {
+		logln("Error:", err)
+		return
+	}
+	logln("Tiga karyawan pertama:")
+	for id, e := range dir.All() {
+		if id > 3 {
+			break
+		}
+		logf("  %d %s\n", id, employeeLabel(e))
+	}
+
+	visited := 0
+	for e := range dir.Reports(1) {
+		visited++
+		if strings.Contains(e.Position, "Engineer") {
+			logf("Engineer terdekat dari CEO: %s (setelah %d orang)\n", employeeLabel(e), visited)
+			break
+		}
+	}
+
+	names := SeqMap(dir.Reports(2), func(e Employee) string { return e.FirstName })
+	for group, n := range SeqZip(SeqChunk(names, 3), Naturals()) {
+		logf("Tim CTO, kelompok %d: %v\n", n, group)
+		if n == 2 {
+			break
+		}
+	}
+
+	// The employees come from a map-backed directory, the ranks from an
+	// infinite sequence; SeqZip stops at the shorter one.
+	for rank, e := range SeqZip(Naturals(), SeqTake(dir.Reports(3), 3)) {
+		logf("  #%d di bawah CFO: %s\n", rank, e.FirstName)
+	}
+
+	shapes := ShapeList{
+		Rectangle{Width: 10, Height: 5},
+		Circle{Radius: 7},
+		Square{Side: 4},
+		Circle{Radius: 1},
+	}
+	for s, area := range shapes.Areas() {
+		logf("%T luas %.2f\n", s, area)
+	}
+	for c := range SeqOfType[Circle](slices.Values(shapes)) {
+		logf("Lingkaran r=%.0f, keliling %.2f\n", c.Radius, c.Perimeter())
+	}
+}
+
+func iteratorCleanupExample() {
+	const text = "satu\ndua\ntiga\nempat\n"
+
+	full := &trackedReader{name: "range sampai habis"}
+	for _, err := range ReadLines(full.open(text)) {
+		if err != nil {
+			logln("Error:", err)
+		}
+	}
+	logln(full)
+
+	early := &trackedReader{name: "break setelah 2 baris"}
+	for line := range ReadLines(early.open(text)) {
+		if line == "dua" {
+			break
+		}
+	}
+	logln(early)
+
+	panicky := &trackedReader{name: "panic di badan loop"}
+	func() {
+		defer func() {
+			logln("Recovered:", recover())
+		}()
+		for line := range ReadLines(panicky.open(text)) {
+			if line == "tiga" {
+				panic("baris tiga tidak boleh")
+			}
+		}
+	}()
+	logln(panicky)
+
+	// iter.Pull turns the push iterator into next/stop calls. The iterator
+	// is suspended between calls, still holding its reader; stop resumes
+	// it with yield returning false so the deferred Close runs.
+	pulled := &trackedReader{name: "iter.Pull + stop"}
+	next, stop := iter.Pull2(ReadLines(pulled.open(text)))
+	first, _, _ := next()
+	second, _, _ := next()
+	logf("Pull: %q, %q, sebelum stop -> ditutup %d kali\n", first, second, pulled.closed)
+	stop()
+	logln(pulled)
+
+	// The open error is delivered through the loop like any other value.
+	failing := ReadLines(func() (io.ReadCloser, error) {
+		return nil, errors.New("file tidak ditemukan")
+	})
+	for _, err := range failing {
+		logln("Error:", err)
+	}
+}
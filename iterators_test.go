@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const linesText = "satu\ndua\ntiga\nempat\n"
+
+func TestReadLinesClosesReader(t *testing.T) {
+	tests := []struct {
+		name string
+		use  func(seq iter.Seq2[string, error])
+	}{
+		{"sampai habis", func(seq iter.Seq2[string, error]) {
+			for _, err := range seq {
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		}},
+		{"break", func(seq iter.Seq2[string, error]) {
+			for line := range seq {
+				if line == "dua" {
+					break
+				}
+			}
+		}},
+		{"return dari badan loop", func(seq iter.Seq2[string, error]) {
+			for range seq {
+				return
+			}
+		}},
+		{"panic di badan loop", func(seq iter.Seq2[string, error]) {
+			defer func() {
+				if recover() == nil {
+					t.Error("panic tidak diteruskan")
+				}
+			}()
+			for line := range seq {
+				if line == "tiga" {
+					panic("berhenti")
+				}
+			}
+		}},
+		{"iter.Pull + stop", func(seq iter.Seq2[string, error]) {
+			next, stop := iter.Pull2(seq)
+			next()
+			next()
+			stop()
+			if _, _, ok := next(); ok {
+				t.Error("next setelah stop masih ok")
+			}
+		}},
+		{"iter.Pull sampai habis tanpa stop", func(seq iter.Seq2[string, error]) {
+			next, _ := iter.Pull2(seq)
+			for {
+				if _, _, ok := next(); !ok {
+					return
+				}
+			}
+		}},
+	}
+	for _, tt := range tests {
+		r := &trackedReader{name: tt.name}
+		tt.use(ReadLines(r.open(linesText)))
+		if r.opened != 1 || r.closed != 1 {
+			t.Errorf("%s: dibuka %d kali, ditutup %d kali; mau 1 dan 1", tt.name, r.opened, r.closed)
+		}
+	}
+
+	// Building the sequence opens nothing; every range opens once more.
+	r := &trackedReader{name: "dua kali"}
+	seq := ReadLines(r.open(linesText))
+	if r.opened != 0 {
+		t.Errorf("ReadLines membuka reader sebelum di-range")
+	}
+	for range seq {
+	}
+	for range seq {
+		break
+	}
+	if r.opened != 2 || r.closed != 2 {
+		t.Errorf("dua kali range: dibuka %d, ditutup %d; mau 2 dan 2", r.opened, r.closed)
+	}
+}
+
+func TestReadLinesLongLines(t *testing.T) {
+	long := strings.Repeat("x", 200<<10)
+	r := &trackedReader{name: "panjang"}
+	var got []string
+	for line, err := range ReadLines(r.open("a\n" + long + "\nb\n")) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, line)
+	}
+	if !slices.Equal(got, []string{"a", long, "b"}) {
+		t.Errorf("baris 200 KiB terbaca sebagai %d baris", len(got))
+	}
+
+	r = &trackedReader{name: "terlalu panjang"}
+	var last error
+	lines := 0
+	for line, err := range ReadLines(r.open("a\n" + strings.Repeat("x", maxLineSize+1) + "\nb\n")) {
+		if err != nil {
+			last = err
+			continue
+		}
+		lines++
+		if line != "a" {
+			t.Errorf("baris %q setelah baris yang terlalu panjang", line[:min(len(line), 10)])
+		}
+	}
+	if lines != 1 || !errors.Is(last, bufio.ErrTooLong) {
+		t.Errorf("%d baris, error %v; mau 1 baris lalu bufio.ErrTooLong", lines, last)
+	}
+	if r.closed != 1 {
+		t.Errorf("reader ditutup %d kali setelah error, mau 1", r.closed)
+	}
+}
+
+// sourceStats watches a sequence: how many values it produced and whether
+// its function returned.
+type sourceStats struct {
+	yielded  int
+	finished bool
+}
+
+// countTo yields 1..n, or forever when n < 0.
+func countTo(n int, st *sourceStats) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer func() { st.finished = true }()
+		for i := 1; n < 0 || i <= n; i++ {
+			st.yielded++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestSeqTakeStopsEarly(t *testing.T) {
+	tests := []struct {
+		name      string
+		n, take   int
+		breakAt   int // 0: no break
+		want      []int
+		wantDrawn int
+	}{
+		{"tak hingga", -1, 3, 0, []int{1, 2, 3}, 3},
+		{"sumber lebih pendek", 2, 5, 0, []int{1, 2}, 2},
+		{"nol", -1, 0, 0, nil, 0},
+		{"negatif", -1, -2, 0, nil, 0},
+		{"break di tengah", -1, 5, 2, []int{1, 2}, 2},
+	}
+	for _, tt := range tests {
+		var st sourceStats
+		var got []int
+		for v := range SeqTake(countTo(tt.n, &st), tt.take) {
+			got = append(got, v)
+			if v == tt.breakAt {
+				break
+			}
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: %v, mau %v", tt.name, got, tt.want)
+		}
+		if st.yielded != tt.wantDrawn {
+			t.Errorf("%s: sumber menghasilkan %d nilai, mau %d", tt.name, st.yielded, tt.wantDrawn)
+		}
+		if tt.wantDrawn > 0 && !st.finished {
+			t.Errorf("%s: sumber tidak selesai", tt.name)
+		}
+	}
+}
+
+func TestSeqZipStopsBoth(t *testing.T) {
+	tests := []struct {
+		name    string
+		na, nb  int
+		breakAt int
+		want    int // pairs
+	}{
+		{"a lebih pendek", 2, -1, 0, 2},
+		{"b lebih pendek", -1, 3, 0, 3},
+		{"sama panjang", 4, 4, 0, 4},
+		{"break", -1, -1, 2, 2},
+		{"b kosong", -1, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		var sa, sb sourceStats
+		pairs := 0
+		for x, y := range SeqZip(countTo(tt.na, &sa), countTo(tt.nb, &sb)) {
+			if x != y {
+				t.Errorf("%s: pasangan (%d, %d) tidak sejajar", tt.name, x, y)
+			}
+			pairs++
+			if x == tt.breakAt {
+				break
+			}
+		}
+		if pairs != tt.want {
+			t.Errorf("%s: %d pasangan, mau %d", tt.name, pairs, tt.want)
+		}
+		if !sa.finished || !sb.finished {
+			t.Errorf("%s: a selesai %t, b selesai %t; mau keduanya", tt.name, sa.finished, sb.finished)
+		}
+	}
+}
+
+func TestSeqChunk(t *testing.T) {
+	var got [][]int
+	for c := range SeqChunk(SeqTake(Naturals(), 7), 3) {
+		got = append(got, c)
+	}
+	want := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("SeqChunk = %v, mau %v", got, want)
+	}
+	got[0][0] = 99
+	if got[1][0] != 4 {
+		t.Error("potongan berbagi memori")
+	}
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// Lesson is one numbered section of the tour. The run command (which is
+// also what main does without arguments) runs them in order; commands
+// such as allocs pick them by ID.
+type Lesson struct {
+	ID    string
+	Title string
+	Run   func()
+}
+
+func init() {
+	registerCommand(command{
+		Name: "run",
+		Usage: "run [-cpuprofile FILE] [-memprofile FILE] [-blockprofile FILE] [-mutexprofile FILE] [-trace FILE] " +
+			"[-top N] [-repeat N] [-pprof ADDR] [-log-format text|json] [PELAJARAN...]",
+		Run: lessonsCommand,
+	})
+}
+
+var lessons = []Lesson{
+	{"hello-world", "2. Hello World", helloWorldExample},
+	{"variabel", "3. Dasar: Variabel", variableExamples},
+	{"konstanta", "3. Dasar: Konstanta", constantsExample},
+	{"bytesize", "3. Dasar: Konstanta (ByteSize)", byteSizeExample},
+	{"tipe-dasar", "3. Dasar: Tipe Dasar", basicTypesExample},
+	{"unicode", "3. Dasar: Tipe Dasar (Inspeksi Unicode)", unicodeExample},
+	{"array", "3. Dasar: Array", arrayExample},
+	{"matriks", "3. Dasar: Array (Matriks Generic)", denseMatrixExample},
+	{"slice", "3. Dasar: Slice", sliceExample},
+	{"slice-aliasing", "3. Dasar: Slice (Aliasing)", sliceAliasExample},
+	{"map", "3. Dasar: Map", mapExample},
+	{"tabel", "3. Dasar: Map (Tabel Data)", tableExample},
+	{"struct", "3. Dasar: Struct", structExample},
+	{"buku-alamat", "3. Dasar: Struct (Buku Alamat)", addressBookExample},
+	{"if-else", "3. Dasar: If/Else", ifElseExample},
+	{"switch", "3. Dasar: Switch", switchExample},
+	{"kalender", "3. Dasar: Switch (Kalender)", calendarExample},
+	{"for", "3. Dasar: For Loop", forLoopExample},
+	{"break-continue", "3. Dasar: Break/Continue", breakContinueExample},
+	{"range-int", "3. Dasar: For Range Integer", forRangeIntExample},
+	{"defer", "3. Dasar: Defer (Simple)", simpleDeferExample},
+	{"defer-args", "3. Dasar: Defer (Args Evaluation)", exampleDeferArgs},
+	{"fungsi", "4. Fungsi", functionExamples},
+	{"kalkulator", "4. Fungsi: Kalkulator Ekspresi", calcExample},
+	{"pointer", "5. Pointer: Dasar", pointerBasics},
+	{"pointer-argumen", "5. Pointer: Argumen Fungsi", pointerArgsExample},
+	{"pointer-opsional", "5. Pointer: Nilai Opsional", pointerForOptionalConfig},
+	{"pointer-struct", "5. Pointer: Struct", pointerToStructExample},
+	{"memlayout", "5. Pointer: Tata Letak Memori", memLayoutExample},
+	{"embedding", "6. Struct & Method: Embedding & Panggil Method", structMethodEmbeddingExample},
+	{"organisasi", "6. Struct & Method: Struktur Organisasi", orgChartExample},
+	{"penggajian", "6. Struct & Method: Penggajian", payrollExample},
+	{"geometri", "6. Struct & Method: Geometri 2D (Generic)", geometryExample},
+	{"interface", "7. Interface: Dasar & Polimorfisme", interfaceExample},
+	{"render", "7. Interface: Render SVG & ASCII", renderExample},
+	{"encoding", "7. Interface: Encoding Polimorfik", shapeCodecExample},
+	{"interface-kosong", "7. Interface: Kosong", emptyInterfaceExample},
+	{"type-assertion", "7. Interface: Type Assertion", typeAssertionExample},
+	{"type-switch", "7. Interface: Type Switch", typeSwitchExample},
+	{"dump", "7. Interface: Reflection (Dump)", dumpExample},
+	{"tictactoe", "7. Interface: Permainan Tic-Tac-Toe", ticTacToeExample},
+	{"error-konvensi", "8. Error Handling: Konvensi", errorHandlingConventionExample},
+	{"error-baru", "8. Error Handling: Pembuatan Error", errorCreationExample},
+	{"error-wrapping", "8. Error Handling: Wrapping", errorWrappingExample},
+	{"error-trace", "8. Error Handling: Stack Trace", errorTraceExample},
+	{"panic-recover", "8. Error Handling: Panic/Recover", panicRecoverExample},
+	{"goroutine", "9. Konkurensi: Goroutine Sederhana", goroutineSimpleExample},
+	{"waitgroup", "9. Konkurensi: WaitGroup", waitGroupExample},
+	{"channel", "9. Konkurensi: Channel Tak Terbuffer", unbufferedChannelExample},
+	{"channel-buffer", "9. Konkurensi: Channel Terbuffer", bufferedChannelExample},
+	{"channel-range", "9. Konkurensi: Range/Close Channel", rangeCloseChannelExample},
+	{"select", "9. Konkurensi: Select", selectExample},
+	{"mutex", "9. Konkurensi: Mutex", mutexExample},
+	{"testing", "11. Testing Examples (Simulated in main)", testingSimulationExample},
+	{"statistik-benchmark", "11. Testing: Statistik Benchmark", benchStatsExample},
+	{"slog", "12. Pustaka Standar: Logging Terstruktur (log/slog)", slogExample},
+	{"http-api", "12. Pustaka Standar: HTTP JSON API", httpAPIExample},
+	{"generics-fungsi", "14. Generics: Map, Filter & Reduce", genericsFuncExample},
+	{"generics-angka", "14. Generics: Constraint Number (Sum & Max)", genericsNumberExample},
+	{"generics-koleksi", "14. Generics: Set, OrderedMap, Stack, Queue & PriorityQueue", genericsCollectionsExample},
+	{"generics-result", "14. Generics: Result & Option", genericsResultOptionExample},
+	{"iterator", "15. Iterator: Map, Filter, Take, Zip & Chunk", iteratorExample},
+	{"iterator-cleanup", "15. Iterator: Break, Panic & iter.Pull", iteratorCleanupExample},
+}
+
+// FuncName is the name of the function behind Run, as it appears in the
+// source, so that compiler diagnostics can be matched to a lesson.
+func (l Lesson) FuncName() string {
+	name := runtime.FuncForPC(reflect.ValueOf(l.Run).Pointer()).Name()
+	return strings.TrimPrefix(name, "main.")
+}
+
+func findLesson(id string) (Lesson, bool) {
+	for _, l := range lessons {
+		if l.ID == id {
+			return l, true
+		}
+	}
+	return Lesson{}, false
+}
+
+// selectLessons returns the lessons with the given IDs, or all of them
+// when ids is empty.
+func selectLessons(ids []string) ([]Lesson, error) {
+	if len(ids) == 0 {
+		return lessons, nil
+	}
+	var selected []Lesson
+	for _, id := range ids {
+		l, ok := findLesson(id)
+		if !ok {
+			return nil, fmt.Errorf("pelajaran %q tidak ada", id)
+		}
+		selected = append(selected, l)
+	}
+	return selected, nil
+}
+
+func runLessons(logger *slog.Logger, list []Lesson) error {
+	for i, l := range list {
+		if i > 0 {
+			logger.Info("")
+		}
+		logger.Info(fmt.Sprintf("--- %s ---", l.Title), "lesson", l.ID)
+		if err := logLesson(logger, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discardStdout runs fn with os.Stdout pointed at /dev/null, for runs
+// whose output nobody reads.
+func discardStdout(fn func()) error {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer devNull.Close()
+	stdout := os.Stdout
+	os.Stdout = devNull
+	defer func() {
+		os.Stdout = stdout
+	}()
+	fn()
+	return nil
+}
+
+// captureStdout runs fn and returns what it printed to os.Stdout.
+func captureStdout(fn func()) (string, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	out := make(chan string, 1)
+	go func() {
+		data, _ := io.ReadAll(r)
+		out <- string(data)
+	}()
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() {
+		os.Stdout = stdout
+	}()
+	fn()
+	w.Close()
+	return <-out, nil
+}
+
+func lessonsCommand(args []string) (err error) {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	var opts ProfileOptions
+	fs.StringVar(&opts.CPUProfile, "cpuprofile", "", "tulis profil CPU ke FILE")
+	fs.StringVar(&opts.MemProfile, "memprofile", "", "tulis profil alokasi memori ke FILE")
+	fs.StringVar(&opts.BlockProfile, "blockprofile", "", "tulis profil blocking goroutine ke FILE")
+	fs.StringVar(&opts.MutexProfile, "mutexprofile", "", "tulis profil perebutan mutex ke FILE")
+	fs.StringVar(&opts.Trace, "trace", "", "tulis execution trace ke FILE")
+	fs.IntVar(&opts.Top, "top", 10, "jumlah fungsi di ringkasan tiap profil (0 = tanpa ringkasan)")
+	repeat := fs.Int("repeat", 1, "jalankan pelajaran N kali; keluaran setelah run pertama dibuang")
+	pprofAddr := fs.String("pprof", "", "layani net/http/pprof di ADDR (mis. localhost:6060) sampai Ctrl+C")
+	logFormat := fs.String("log-format", "text", "format keluaran pelajaran: text atau json")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	handler, err := newLessonHandler(*logFormat, os.Stdout)
+	if err != nil {
+		return err
+	}
+	logger := slog.New(handler)
+	if *repeat < 1 {
+		return fmt.Errorf("%w: -repeat harus minimal 1", errUsage)
+	}
+	list, err := selectLessons(fs.Args())
+	if err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+
+	if *pprofAddr != "" {
+		// Without these rates the block and mutex pages stay empty.
+		runtime.SetBlockProfileRate(1)
+		runtime.SetMutexProfileFraction(1)
+		srv, url, serveErr, startErr := startPprofServer(*pprofAddr)
+		if startErr != nil {
+			return startErr
+		}
+		logger.Info("pprof tersedia di "+url, "url", url)
+		defer func() {
+			logger.Info("")
+			logger.Info("Pelajaran selesai; pprof tetap aktif di "+url+" (Ctrl+C untuk berhenti)", "url", url)
+			if shutdownErr := waitForInterrupt(srv, serveErr); err == nil {
+				err = shutdownErr
+			}
+		}()
+	}
+
+	var session *profileSession
+	if opts.enabled() {
+		if session, err = startProfiling(opts); err != nil {
+			return err
+		}
+		// Calling Stop again is a no-op; this one covers the early returns.
+		defer session.Stop()
+	}
+	if err := runLessons(logger, list); err != nil {
+		return err
+	}
+	quiet := slog.New(slog.DiscardHandler)
+	for i := 1; i < *repeat; i++ {
+		if err := runLessons(quiet, list); err != nil {
+			return err
+		}
+	}
+	logger.Info("")
+	logger.Info("--- Selesai ---")
+	if *repeat > 1 {
+		logger.Info(fmt.Sprintf("(pelajaran dijalankan %d kali; keluaran run berikutnya dibuang)", *repeat), "repeat", *repeat)
+	}
+
+	if session == nil {
+		return nil
+	}
+	if err := session.Stop(); err != nil {
+		return err
+	}
+	fmt.Println()
+	session.WriteSummaries(os.Stdout)
+	return nil
+}
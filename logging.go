@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Lessons talk to the reader through log/slog records. They write text
+// with logln and logf, which log each line to lessonLogger, and code that
+// knows more than a line of text, such as which worker is speaking or
+// which errors are wrapped inside which, uses logfTo with a logger made
+// by lessonLogger.With. Printers that take an io.Writer go through
+// logOutput.
+// As a safety net the runner also captures os.Stdout through a pipe while
+// a lesson runs and turns every line printed there into a record with the
+// lesson's ID; the pipe is read on its own goroutine, so a lesson that
+// mixed fmt and the logger could see its lines reordered.
+//
+// os.Stdout and lessonLogger are globals. logLesson swaps them under
+// lessonMu, and a lesson must wait for its goroutines before Run returns:
+// a goroutine that logs afterwards races with the swap back.
+//
+// -log-format text prints every record as its bare message, which is
+// exactly the output from before records existed; -log-format json prints
+// one object per record for tools that filter or parse.
+
+// lessonLogger is what lessons log to. Outside the runner it prints to
+// whatever os.Stdout is at the time, so discardStdout silences it too; the
+// runner swaps in a logger with the lesson's ID for each lesson.
+var lessonLogger = slog.New(newPlainHandler(stdoutWriter{}))
+
+// lessonMu serializes logLesson.
+var lessonMu sync.Mutex
+
+type stdoutWriter struct{}
+
+func (stdoutWriter) Write(p []byte) (int, error) {
+	return os.Stdout.Write(p)
+}
+
+// plainHandler writes only the message of each record and ignores the
+// attributes.
+type plainHandler struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+func newPlainHandler(w io.Writer) *plainHandler {
+	return &plainHandler{mu: &sync.Mutex{}, w: w}
+}
+
+func (h *plainHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= slog.LevelInfo
+}
+
+func (h *plainHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, r.Message+"\n")
+	return err
+}
+
+func (h *plainHandler) WithAttrs([]slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *plainHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+// newLessonHandler returns the handler behind -log-format for lesson
+// output.
+func newLessonHandler(format string, w io.Writer) (slog.Handler, error) {
+	switch format {
+	case "text":
+		return newPlainHandler(w), nil
+	case "json":
+		return slog.NewJSONHandler(w, nil), nil
+	}
+	return nil, fmt.Errorf("%w: -log-format %q (pilih text atau json)", errUsage, format)
+}
+
+// LogEntry is a record as RecordingHandler keeps it: no timestamp, and
+// attributes flattened to "group.key" so they compare as plain values.
+type LogEntry struct {
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+func (e LogEntry) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %q", e.Level, e.Message)
+	for _, a := range e.Attrs {
+		fmt.Fprintf(&sb, " %s=%v", a.Key, a.Value)
+	}
+	return sb.String()
+}
+
+// RecordingHandler keeps every record in memory instead of writing it, so
+// a run can be compared against a golden file line by line. Loggers
+// derived with With and WithGroup share the recording.
+type RecordingHandler struct {
+	mu      *sync.Mutex
+	entries *[]LogEntry
+	attrs   []slog.Attr
+	prefix  string
+}
+
+func NewRecordingHandler() *RecordingHandler {
+	return &RecordingHandler{mu: &sync.Mutex{}, entries: &[]LogEntry{}}
+}
+
+func (h *RecordingHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *RecordingHandler) Handle(_ context.Context, r slog.Record) error {
+	attrs := slices.Clone(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendFlat(attrs, h.prefix, a)
+		return true
+	})
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	*h.entries = append(*h.entries, LogEntry{Level: r.Level, Message: r.Message, Attrs: attrs})
+	return nil
+}
+
+func (h *RecordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.attrs = slices.Clone(h.attrs)
+	for _, a := range attrs {
+		c.attrs = appendFlat(c.attrs, h.prefix, a)
+	}
+	return &c
+}
+
+func (h *RecordingHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	c := *h
+	c.prefix = h.prefix + name + "."
+	return &c
+}
+
+func (h *RecordingHandler) Entries() []LogEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(*h.entries)
+}
+
+// Golden renders the recording one entry per line, the form golden files
+// are stored in.
+func (h *RecordingHandler) Golden() string {
+	var sb strings.Builder
+	for _, e := range h.Entries() {
+		sb.WriteString(e.String())
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// appendFlat resolves LogValuers and spreads groups into prefixed keys.
+func appendFlat(attrs []slog.Attr, prefix string, a slog.Attr) []slog.Attr {
+	a.Value = a.Value.Resolve()
+	if a.Value.Kind() != slog.KindGroup {
+		return append(attrs, slog.Attr{Key: prefix + a.Key, Value: a.Value})
+	}
+	if a.Key != "" {
+		prefix += a.Key + "."
+	}
+	for _, ga := range a.Value.Group() {
+		attrs = appendFlat(attrs, prefix, ga)
+	}
+	return attrs
+}
+
+// errorChain logs an error as its full message plus one entry per layer
+// of wrapping, outermost first, in the same walk as the error tree of %+v.
+type errorChain struct {
+	err error
+}
+
+func errorAttr(err error) slog.Attr {
+	return slog.Any("error", errorChain{err})
+}
+
+func (c errorChain) LogValue() slog.Value {
+	if c.err == nil {
+		return slog.StringValue("<nil>")
+	}
+	var chain []string
+	var walk func(err error)
+	walk = func(err error) {
+		chain = append(chain, fmt.Sprintf("%T: %s", err, layerMessage(err)))
+		for _, cause := range errorCauses(err) {
+			walk(cause)
+		}
+	}
+	walk(c.err)
+	return slog.GroupValue(slog.String("msg", c.err.Error()), slog.Any("chain", chain))
+}
+
+// logln is fmt.Println for lessons: every line of the text becomes an
+// Info record on lessonLogger.
+func logln(a ...any) {
+	logLines(fmt.Sprintln(a...))
+}
+
+// logf is fmt.Printf for lessons. Each call ends a line, with or without
+// a trailing "\n" in format.
+func logf(format string, a ...any) {
+	logLines(fmt.Sprintf(format, a...))
+}
+
+// logfTo is logf for lines with attributes: it logs to logger, which is
+// lessonLogger or one derived from it with With.
+func logfTo(logger *slog.Logger, format string, a ...any) {
+	logLinesTo(logger, fmt.Sprintf(format, a...))
+}
+
+// logOutput collects what write writes to w and logs it line by line,
+// for the printers that take an io.Writer.
+func logOutput(write func(w io.Writer)) {
+	var sb strings.Builder
+	write(&sb)
+	logLines(sb.String())
+}
+
+func logLines(text string) {
+	logLinesTo(lessonLogger, text)
+}
+
+func logLinesTo(logger *slog.Logger, text string) {
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		logger.Info(line)
+	}
+}
+
+// logLesson runs l with its output going to logger: lessonLogger gets the
+// lesson's ID as an attribute, and every line printed to os.Stdout
+// becomes a record of its own. If l panics, the pipe is still closed and
+// drained before the panic goes on.
+func logLesson(logger *slog.Logger, l Lesson) (err error) {
+	lessonMu.Lock()
+	defer lessonMu.Unlock()
+	logger = logger.With("lesson", l.ID)
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		// Not a bufio.Scanner: it would also strip the \r of the CRLF
+		// lines the vCard export prints.
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if line != "" {
+				logger.Info(strings.TrimSuffix(line, "\n"))
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				done <- err
+				return
+			}
+		}
+	}()
+
+	stdout, prev := os.Stdout, lessonLogger
+	os.Stdout, lessonLogger = w, logger
+	defer func() {
+		os.Stdout, lessonLogger = stdout, prev
+		w.Close()
+		if readErr := <-done; err == nil {
+			err = readErr
+		}
+	}()
+	l.Run()
+	return nil
+}
+
+func slogExample() {
+	rec := NewRecordingHandler()
+	logger := slog.New(rec).With("lesson", "slog")
+	logger.Info("Worker 1: Memulai", "worker", 1)
+	logger.WithGroup("http").Info("permintaan", "method", "GET", "status", 200)
+	err := loadConfigSimplified("config.yaml", true)
+	logger.Error("Error utama", errorAttr(err))
+
+	logln("Rekaman RecordingHandler (bentuk file golden):")
+	logf("%s", rec.Golden())
+
+	// The same records through the JSON handler, without the timestamp so
+	// the output stays the same from run to run.
+	logln("\nJSONHandler:")
+	noTime := func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) == 0 && a.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return a
+	}
+	var sb strings.Builder
+	jsonLogger := slog.New(slog.NewJSONHandler(&sb, &slog.HandlerOptions{ReplaceAttr: noTime}))
+	for _, e := range rec.Entries() {
+		jsonLogger.LogAttrs(context.Background(), e.Level, e.Message, e.Attrs...)
+	}
+	logf("%s", sb.String())
+
+	logln("\nplainHandler (format text bawaan pelajaran) hanya mencetak pesannya:")
+	logOutput(func(w io.Writer) {
+		plain := slog.New(newPlainHandler(w)).With("lesson", "slog")
+		plain.Info("Worker 1: Memulai", "worker", 1)
+	})
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func recordLesson(t *testing.T, id string) *RecordingHandler {
+	t.Helper()
+	l, ok := findLesson(id)
+	if !ok {
+		t.Fatalf("pelajaran %q tidak ada", id)
+	}
+	rec := NewRecordingHandler()
+	if err := logLesson(slog.New(rec), l); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range rec.Entries() {
+		if attrValue(e, "lesson") != id {
+			t.Errorf("%s: catatan tanpa atribut lesson: %v", id, e)
+		}
+	}
+	return rec
+}
+
+func attrValue(e LogEntry, key string) string {
+	for _, a := range e.Attrs {
+		if a.Key == key {
+			return a.Value.String()
+		}
+	}
+	return ""
+}
+
+// goLine matches the line numbers of creation sites, which move with
+// every edit above them.
+var goLine = regexp.MustCompile(`\.go:\d+`)
+
+func TestLessonGolden(t *testing.T) {
+	rec := recordLesson(t, "error-wrapping")
+	got := goLine.ReplaceAllString(rec.Golden(), ".go:N")
+	checkGolden(t, "lesson_error-wrapping.golden", got)
+}
+
+// TestWaitGroupOrder checks the order that wg.Wait guarantees. How the
+// workers interleave changes from run to run, so there is no golden file.
+func TestWaitGroupOrder(t *testing.T) {
+	rec := recordLesson(t, "waitgroup")
+	entries := rec.Entries()
+	index := map[string]int{}
+	for i, e := range entries {
+		if _, dup := index[e.Message]; dup {
+			t.Errorf("pesan %q muncul dua kali", e.Message)
+		}
+		index[e.Message] = i
+	}
+	pos := func(msg string) int {
+		t.Helper()
+		i, ok := index[msg]
+		if !ok {
+			t.Fatalf("pesan %q tidak ada di\n%s", msg, rec.Golden())
+		}
+		return i
+	}
+
+	if pos("Memulai 3 worker...") != 0 {
+		t.Errorf("\"Memulai 3 worker...\" bukan catatan pertama:\n%s", rec.Golden())
+	}
+	done := pos("Main: Semua worker telah selesai.")
+	if done != len(entries)-1 {
+		t.Errorf("\"Semua worker telah selesai\" bukan catatan terakhir:\n%s", rec.Golden())
+	}
+	pos("Main: Menunggu semua worker selesai...")
+	for id := 1; id <= 3; id++ {
+		start, end := pos(fmt.Sprintf("Worker %d: Memulai", id)), pos(fmt.Sprintf("Worker %d: Selesai", id))
+		if start > end || end > done {
+			t.Errorf("worker %d: memulai di %d, selesai di %d, main selesai di %d", id, start, end, done)
+		}
+		for _, i := range []int{start, end} {
+			if w := attrValue(entries[i], "worker"); w != strconv.Itoa(id) {
+				t.Errorf("%q: atribut worker %q, mau %d", entries[i].Message, w, id)
+			}
+		}
+	}
+	if len(entries) != 9 {
+		t.Errorf("%d catatan, mau 9:\n%s", len(entries), rec.Golden())
+	}
+}
+
+func TestLogLinesSplitsText(t *testing.T) {
+	rec := NewRecordingHandler()
+	prev := lessonLogger
+	lessonLogger = slog.New(rec)
+	defer func() { lessonLogger = prev }()
+
+	logln("a", 1)
+	logf("b\nc\n")
+	logf("tanpa baris baru")
+	logln()
+	logf("%s", "")
+	logOutput(func(w io.Writer) { io.WriteString(w, "d\ne\n") })
+
+	var got []string
+	for _, e := range rec.Entries() {
+		got = append(got, e.Message)
+	}
+	want := []string{"a 1", "b", "c", "tanpa baris baru", "", "d", "e"}
+	if !slices.Equal(got, want) {
+		t.Errorf("pesan %q, mau %q", got, want)
+	}
+}
+
+func TestLogLessonRestoresAfterPanic(t *testing.T) {
+	stdout, logger := os.Stdout, lessonLogger
+	rec := NewRecordingHandler()
+	boom := Lesson{ID: "boom", Run: func() {
+		os.Stdout.WriteString("lewat pipa\n")
+		logln("lewat logger")
+		panic("meledak")
+	}}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("panic tidak diteruskan")
+			}
+		}()
+		logLesson(slog.New(rec), boom)
+	}()
+	if os.Stdout != stdout || lessonLogger != logger {
+		t.Fatal("os.Stdout atau lessonLogger tidak dikembalikan setelah panic")
+	}
+
+	var got []string
+	for _, e := range rec.Entries() {
+		got = append(got, e.Message)
+	}
+	slices.Sort(got)
+	if want := []string{"lewat logger", "lewat pipa"}; !slices.Equal(got, want) {
+		t.Errorf("pesan %q, mau %q", got, want)
+	}
+
+	// lessonMu was released: the next lesson runs.
+	if err := logLesson(slog.New(rec), Lesson{ID: "lanjut", Run: func() {}}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,466 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// The pointer lessons print %p addresses such as 0xc000012345, which say
+// little on their own. This file shows what is behind them: how many bytes
+// a value takes, where each struct field sits inside it, and which
+// variable points at which, drawn as boxes and arrows.
+
+type TypeLayout struct {
+	Type   string
+	Size   uintptr
+	Align  uintptr
+	Fields []FieldLayout
+}
+
+type FieldLayout struct {
+	Name    string
+	Type    string
+	Offset  uintptr
+	Size    uintptr
+	Align   uintptr
+	Padding uintptr // unused bytes after this field
+}
+
+// PointerGraph holds named variables and the pointers between them.
+// Variables are registered with their address (Var("x", &x)); pointers
+// to memory that has no registered name get an anonymous node.
+type PointerGraph struct {
+	Nodes []*PointerNode
+	Edges [][2]int
+	// HideAddresses prints &1, &2, … instead of real addresses.
+	HideAddresses bool
+}
+
+type PointerNode struct {
+	Name  string
+	Type  string
+	Addr  uintptr
+	Value string // empty for non-nil pointers, which are drawn as arrows
+	value reflect.Value
+}
+
+// paddedRecord is laid out badly on purpose: each bool forces padding in
+// front of the wider field that follows it.
+type paddedRecord struct {
+	Active bool
+	ID     int64
+	Hidden bool
+	Count  int32
+}
+
+func init() {
+	registerCommand(command{
+		Name:  "memlayout",
+		Usage: "memlayout [-escape] [-src DIR] [-svg FILE] [-hide-addr]",
+		Run:   memLayoutCommand,
+	})
+}
+
+func LayoutOf(v any) TypeLayout {
+	return LayoutOfType(reflect.TypeOf(v))
+}
+
+func LayoutOfType(t reflect.Type) TypeLayout {
+	l := TypeLayout{Type: t.String(), Size: t.Size(), Align: uintptr(t.Align())}
+	if t.Kind() != reflect.Struct {
+		return l
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		l.Fields = append(l.Fields, FieldLayout{
+			Name:   f.Name,
+			Type:   f.Type.String(),
+			Offset: f.Offset,
+			Size:   f.Type.Size(),
+			Align:  uintptr(f.Type.Align()),
+		})
+	}
+	for i := range l.Fields {
+		end := l.Size
+		if i+1 < len(l.Fields) {
+			end = l.Fields[i+1].Offset
+		}
+		l.Fields[i].Padding = end - l.Fields[i].Offset - l.Fields[i].Size
+	}
+	return l
+}
+
+// PackedSize is the size the struct would have with its fields ordered
+// from the largest alignment to the smallest, which never needs padding
+// between fields.
+func (l TypeLayout) PackedSize() uintptr {
+	fields := append([]FieldLayout(nil), l.Fields...)
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Align > fields[j].Align })
+	var size uintptr
+	for _, f := range fields {
+		size = alignUp(size, f.Align) + f.Size
+	}
+	return alignUp(size, l.Align)
+}
+
+func alignUp(n, align uintptr) uintptr {
+	if align == 0 {
+		return n
+	}
+	return (n + align - 1) / align * align
+}
+
+// ByteMap draws one character per byte: a letter per field (a, b, c, …)
+// and '.' for padding, in groups of eight.
+func (l TypeLayout) ByteMap() string {
+	cells := []byte(strings.Repeat(".", int(l.Size)))
+	for i, f := range l.Fields {
+		for b := f.Offset; b < f.Offset+f.Size; b++ {
+			cells[b] = byte('a' + i%26)
+		}
+	}
+	var groups []string
+	for i := 0; i < len(cells); i += 8 {
+		groups = append(groups, string(cells[i:min(i+8, len(cells))]))
+	}
+	return "[" + strings.Join(groups, " ") + "]"
+}
+
+func (l TypeLayout) Write(w io.Writer) {
+	fmt.Fprintf(w, "%s: ukuran %d byte, alignment %d\n", l.Type, l.Size, l.Align)
+	if len(l.Fields) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "  %6s  %6s  %5s  %s\n", "offset", "ukuran", "align", "field")
+	var padding uintptr
+	for i, f := range l.Fields {
+		fmt.Fprintf(w, "  %6d  %6d  %5d  %c %s %s\n", f.Offset, f.Size, f.Align, 'a'+i%26, f.Name, f.Type)
+		if f.Padding > 0 {
+			fmt.Fprintf(w, "  %6d  %6d  %5s  . (padding)\n", f.Offset+f.Size, f.Padding, "")
+			padding += f.Padding
+		}
+	}
+	if l.Size <= 64 {
+		fmt.Fprintf(w, "  %s\n", l.ByteMap())
+	}
+	if packed := l.PackedSize(); packed < l.Size {
+		fmt.Fprintf(w, "  %d byte padding; dengan field diurutkan dari alignment terbesar cukup %d byte\n", padding, packed)
+	}
+}
+
+// Var registers a variable by its address, e.g. g.Var("p", &p).
+func (g *PointerGraph) Var(name string, addr any) {
+	v := reflect.ValueOf(addr)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		panic("PointerGraph.Var membutuhkan alamat variabel, mis. &x")
+	}
+	g.Nodes = append(g.Nodes, &PointerNode{Name: name, Type: v.Elem().Type().String(), Addr: v.Pointer(), value: v.Elem()})
+}
+
+func (g *PointerGraph) nodeAt(addr uintptr, t reflect.Type) int {
+	for i, n := range g.Nodes {
+		if n.Addr == addr && n.Type == t.String() {
+			return i
+		}
+	}
+	return -1
+}
+
+// resolve turns pointer values into edges, adding anonymous nodes for
+// targets that were not registered (e.g. the result of new(string)).
+func (g *PointerGraph) resolve() {
+	g.Edges = nil
+	for i := 0; i < len(g.Nodes); i++ {
+		n := g.Nodes[i]
+		v := n.value
+		if v.Kind() != reflect.Pointer {
+			n.Value = fmt.Sprintf("%+v", formatForGraph(v))
+			continue
+		}
+		if v.IsNil() {
+			n.Value = "nil"
+			continue
+		}
+		n.Value = ""
+		target := g.nodeAt(v.Pointer(), v.Elem().Type())
+		if target < 0 {
+			g.Nodes = append(g.Nodes, &PointerNode{
+				Name:  "*" + n.Name,
+				Type:  v.Elem().Type().String(),
+				Addr:  v.Pointer(),
+				value: v.Elem(),
+			})
+			target = len(g.Nodes) - 1
+		}
+		g.Edges = append(g.Edges, [2]int{i, target})
+	}
+}
+
+func formatForGraph(v reflect.Value) any {
+	if v.CanInterface() {
+		if s, ok := v.Interface().(string); ok {
+			return fmt.Sprintf("%q", s)
+		}
+		return v.Interface()
+	}
+	return v.String()
+}
+
+// order lists the nodes so that chains come out left to right: every root
+// (a node nothing points to) followed by what it points at.
+func (g *PointerGraph) order() []int {
+	incoming := make([]bool, len(g.Nodes))
+	next := make([]int, len(g.Nodes))
+	for i := range next {
+		next[i] = -1
+	}
+	for _, e := range g.Edges {
+		incoming[e[1]] = true
+		next[e[0]] = e[1]
+	}
+
+	var order []int
+	placed := make([]bool, len(g.Nodes))
+	walk := func(i int) {
+		for ; i >= 0 && !placed[i]; i = next[i] {
+			placed[i] = true
+			order = append(order, i)
+		}
+	}
+	for i := range g.Nodes {
+		if !incoming[i] {
+			walk(i)
+		}
+	}
+	for i := range g.Nodes { // cycles have no root
+		walk(i)
+	}
+	return order
+}
+
+func (g *PointerGraph) address(i int) string {
+	if g.HideAddresses {
+		return fmt.Sprintf("&%d", i+1)
+	}
+	return fmt.Sprintf("0x%x", g.Nodes[i].Addr)
+}
+
+func (g *PointerGraph) target(i int) int {
+	for _, e := range g.Edges {
+		if e[0] == i {
+			return e[1]
+		}
+	}
+	return -1
+}
+
+// ASCII draws the nodes as a row of boxes. An arrow joins neighbouring
+// boxes; pointers to boxes further away are listed underneath.
+func (g *PointerGraph) ASCII() string {
+	g.resolve()
+	order := g.order()
+	widths := make([]int, len(order))
+	labels := make([]string, len(order))
+	for p, i := range order {
+		n := g.Nodes[i]
+		labels[p] = n.Name + " " + n.Type
+		widths[p] = max(displayLen(labels[p]), displayLen(n.Value), displayLen(g.address(i)), 5) + 2
+	}
+
+	const gap = 5
+	var top, upper, middle, lower, bottom strings.Builder
+	var far []string
+	for p, i := range order {
+		n := g.Nodes[i]
+		w := widths[p]
+		target := g.target(i)
+		adjacent := target >= 0 && p+1 < len(order) && order[p+1] == target
+
+		top.WriteString(" " + padRight(labels[p], w+gap-1))
+		upper.WriteString("┌" + strings.Repeat("─", w) + "┐" + strings.Repeat(" ", gap-2))
+		lower.WriteString("└" + strings.Repeat("─", w) + "┘" + strings.Repeat(" ", gap-2))
+		bottom.WriteString(" " + padRight(g.address(i), w+gap-1))
+
+		switch {
+		case adjacent:
+			left := (w - 1) / 2
+			middle.WriteString("│" + strings.Repeat(" ", left) + "●" + strings.Repeat("─", w-left-1) + "┼" + strings.Repeat("─", gap-3) + "▶")
+		case target >= 0:
+			middle.WriteString("│" + centre("● → "+g.Nodes[target].Name, w) + "│" + strings.Repeat(" ", gap-2))
+			far = append(far, fmt.Sprintf("%s ──▶ %s", n.Name, g.Nodes[target].Name))
+		default:
+			middle.WriteString("│" + centre(n.Value, w) + "│" + strings.Repeat(" ", gap-2))
+		}
+	}
+
+	var sb strings.Builder
+	for _, line := range []*strings.Builder{&top, &upper, &middle, &lower, &bottom} {
+		sb.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+	}
+	for _, f := range far {
+		sb.WriteString("  " + f + "\n")
+	}
+	return sb.String()
+}
+
+func displayLen(s string) int {
+	return len([]rune(s))
+}
+
+func padRight(s string, w int) string {
+	return s + strings.Repeat(" ", max(w-displayLen(s), 0))
+}
+
+func centre(s string, w int) string {
+	left := (w - displayLen(s)) / 2
+	return padRight(strings.Repeat(" ", max(left, 0))+s, w)
+}
+
+// WriteSVG draws the same row of boxes; pointers between neighbours are
+// straight arrows, longer ones arc over the boxes in between.
+func (g *PointerGraph) WriteSVG(w io.Writer) error {
+	g.resolve()
+	order := g.order()
+	const boxW, boxH, gap, top = 150.0, 40.0, 60.0, 70.0
+	x := make([]float64, len(g.Nodes))
+	for p, i := range order {
+		x[i] = 20 + float64(p)*(boxW+gap)
+	}
+	width := 40 + float64(len(order))*(boxW+gap) - gap
+	height := top + boxH + 50
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" font-family="monospace" font-size="13">`+"\n", width, height)
+	sb.WriteString(`  <defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="#333"/></marker></defs>` + "\n")
+	for _, i := range order {
+		n := g.Nodes[i]
+		fmt.Fprintf(&sb, `  <text x="%.0f" y="%.0f">%s</text>`+"\n", x[i], top-8, svgEscape(n.Name+" "+n.Type))
+		fmt.Fprintf(&sb, `  <rect x="%.0f" y="%.0f" width="%.0f" height="%.0f" fill="#e8f0fe" stroke="#333"/>`+"\n", x[i], top, boxW, boxH)
+		fmt.Fprintf(&sb, `  <text x="%.0f" y="%.0f" fill="#666">%s</text>`+"\n", x[i], top+boxH+18, svgEscape(g.address(i)))
+		if n.Value != "" {
+			fmt.Fprintf(&sb, `  <text x="%.0f" y="%.0f" text-anchor="middle">%s</text>`+"\n", x[i]+boxW/2, top+boxH/2+5, svgEscape(n.Value))
+		}
+	}
+	for _, e := range g.Edges {
+		from, to := e[0], e[1]
+		sx, y := x[from]+boxW/2, top+boxH/2
+		fmt.Fprintf(&sb, `  <circle cx="%.0f" cy="%.0f" r="4" fill="#333"/>`+"\n", sx, y)
+		if x[to] == x[from]+boxW+gap {
+			fmt.Fprintf(&sb, `  <line x1="%.0f" y1="%.0f" x2="%.0f" y2="%.0f" stroke="#333" marker-end="url(#arrow)"/>`+"\n", sx, y, x[to], y)
+			continue
+		}
+		tx := x[to] + boxW/2
+		fmt.Fprintf(&sb, `  <path d="M%.0f,%.0f Q%.0f,%.0f %.0f,%.0f" fill="none" stroke="#333" marker-end="url(#arrow)"/>`+"\n",
+			sx, top, (sx+tx)/2, top-60, tx, top)
+	}
+	sb.WriteString("</svg>\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func svgEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(s)
+}
+
+func examplePointerGraph(hide bool) *PointerGraph {
+	x := 200
+	p := &x
+	pp := &p
+	g := &PointerGraph{HideAddresses: hide}
+	g.Var("pp", &pp)
+	g.Var("p", &p)
+	g.Var("x", &x)
+	return g
+}
+
+var pointerLessonFuncs = []string{"pointerBasics", "pointerArgsExample", "pointerToStructExample"}
+
+func memLayoutCommand(args []string) error {
+	fs := flag.NewFlagSet("memlayout", flag.ContinueOnError)
+	escape := fs.Bool("escape", false, "jalankan analisis escape (go build -gcflags=-m) pada contoh pointer")
+	src := fs.String("src", ".", "direktori sumber program pelajaran")
+	svg := fs.String("svg", "", "simpan diagram pointer pp -> p -> x sebagai SVG")
+	hide := fs.Bool("hide-addr", false, "tampilkan &1, &2, … alih-alih alamat asli")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	for _, v := range []any{Vertex{}, Person{}, Manager{}, paddedRecord{}} {
+		logOutput(func(w io.Writer) { LayoutOf(v).Write(w) })
+		logln()
+	}
+
+	g := examplePointerGraph(*hide)
+	logf("%s", g.ASCII())
+	if *svg != "" {
+		f, err := os.Create(*svg)
+		if err != nil {
+			return fmt.Errorf("gagal membuat file SVG: %w", err)
+		}
+		if err := g.WriteSVG(f); err != nil {
+			f.Close()
+			return fmt.Errorf("gagal menulis SVG: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		logln("Diagram disimpan ke", *svg)
+	}
+
+	if !*escape {
+		return nil
+	}
+	diags, err := RunEscapeAnalysis(*src, 1)
+	if err != nil {
+		return err
+	}
+	if err := AttachFuncs(diags); err != nil {
+		return err
+	}
+	byFunc := EscapesByFunc(diags, pointerLessonFuncs...)
+	logln("\nTanda ! berarti nilai dialokasikan di heap, bukan di stack.")
+	for _, name := range pointerLessonFuncs {
+		logf("\nAnalisis escape %s:\n", name)
+		if len(byFunc[name]) == 0 {
+			logln("  (tidak ada catatan dari compiler)")
+		}
+		for _, d := range byFunc[name] {
+			marker := " "
+			if d.Escapes() {
+				marker = "!"
+			}
+			logf("  %s %s\n", marker, d)
+		}
+	}
+	return nil
+}
+
+func memLayoutExample() {
+	var x int
+	var p *int
+	logf("unsafe.Sizeof: int=%d, *int=%d, Vertex=%d, string=%d, []int=%d\n",
+		unsafe.Sizeof(x), unsafe.Sizeof(p), unsafe.Sizeof(Vertex{}), unsafe.Sizeof(""), unsafe.Sizeof([]int(nil)))
+	logOutput(func(w io.Writer) { LayoutOf(Person{}).Write(w) })
+	logOutput(func(w io.Writer) { LayoutOf(paddedRecord{}).Write(w) })
+
+	logln("\nRantai pointer dari pointerBasics:")
+	logf("%s", examplePointerGraph(true).ASCII())
+
+	v := Vertex{1, 2}
+	vp := &v
+	s := new(string)
+	*s = "Halo dari new()"
+	g := &PointerGraph{HideAddresses: true}
+	g.Var("vp", &vp)
+	g.Var("v", &v)
+	g.Var("s", &s)
+	logln("\nPointer ke struct dan hasil new():")
+	logf("%s", g.ASCII())
+	logln("(Jalankan `memlayout -escape` untuk melihat variabel mana yang pindah ke heap.)")
+}
@@ -0,0 +1,639 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed data/employees.csv
+var sampleEmployeesCSV string
+
+type OrgMember struct {
+	Employee        Employee
+	Department      string
+	ManagerID       int
+	HireDate        time.Time
+	TerminationDate time.Time
+}
+
+// Directory links employees to the managers they report to. A ManagerID of
+// zero marks the top of a tree; a directory may hold several trees.
+//
+// children indexes the same links the other way round, manager ID to the
+// sorted IDs of its direct reports, so walking down the tree never scans
+// every member. Every change to a ManagerID goes through link and unlink.
+type Directory struct {
+	members  map[int]*OrgMember
+	children map[int][]int
+}
+
+type DepartmentStats struct {
+	Department  string
+	Headcount   int
+	Active      int
+	TotalSalary float64
+}
+
+type SpanOfControl struct {
+	Employee Employee
+	Direct   int
+	Total    int
+}
+
+var (
+	ErrEmployeeNotFound  = errors.New("karyawan tidak ditemukan")
+	ErrDuplicateEmployee = errors.New("ID karyawan sudah dipakai")
+)
+
+type CycleError struct {
+	IDs []int
+}
+
+func (e *CycleError) Error() string {
+	parts := make([]string, len(e.IDs))
+	for i, id := range e.IDs {
+		parts[i] = "#" + strconv.Itoa(id)
+	}
+	return "siklus pelaporan: " + strings.Join(parts, " -> ")
+}
+
+func init() {
+	registerCommand(command{
+		Name:  "orgchart",
+		Usage: "orgchart [-format text|dot|stats] FILE.csv",
+		Run:   orgChartCommand,
+	})
+}
+
+func NewDirectory() *Directory {
+	return &Directory{members: map[int]*OrgMember{}, children: map[int][]int{}}
+}
+
+func (d *Directory) link(id, managerID int) {
+	if managerID == 0 {
+		return
+	}
+	ids := d.children[managerID]
+	i, _ := slices.BinarySearch(ids, id)
+	d.children[managerID] = slices.Insert(ids, i, id)
+}
+
+func (d *Directory) unlink(id, managerID int) {
+	ids := d.children[managerID]
+	if i, ok := slices.BinarySearch(ids, id); ok {
+		ids = slices.Delete(ids, i, i+1)
+	}
+	if len(ids) == 0 {
+		delete(d.children, managerID)
+	} else {
+		d.children[managerID] = ids
+	}
+}
+
+// setManager moves id under managerID in both members and children.
+func (d *Directory) setManager(m *OrgMember, managerID int) {
+	d.unlink(m.Employee.ID, m.ManagerID)
+	m.ManagerID = managerID
+	d.link(m.Employee.ID, managerID)
+}
+
+func (s DepartmentStats) ActiveRatio() float64 {
+	if s.Headcount == 0 {
+		return 0
+	}
+	return float64(s.Active) / float64(s.Headcount)
+}
+
+// Add inserts e under managerID, which like in SetManager must already be
+// in the directory (or zero). A new member has no reports yet, so it
+// cannot close a cycle unless it names itself as manager.
+func (d *Directory) Add(e Employee, department string, managerID int) error {
+	if _, exists := d.members[e.ID]; exists {
+		return fmt.Errorf("karyawan #%d: %w", e.ID, ErrDuplicateEmployee)
+	}
+	if managerID == e.ID {
+		return &CycleError{IDs: []int{e.ID, e.ID}}
+	}
+	if _, ok := d.members[managerID]; managerID != 0 && !ok {
+		return fmt.Errorf("manajer #%d: %w", managerID, ErrEmployeeNotFound)
+	}
+	d.members[e.ID] = &OrgMember{Employee: e, Department: department, ManagerID: managerID}
+	d.link(e.ID, managerID)
+	return nil
+}
+
+func (d *Directory) Get(id int) (OrgMember, error) {
+	m, ok := d.members[id]
+	if !ok {
+		return OrgMember{}, fmt.Errorf("karyawan #%d: %w", id, ErrEmployeeNotFound)
+	}
+	return *m, nil
+}
+
+// SetManager changes who id reports to and refuses changes that would
+// make someone their own (indirect) manager.
+func (d *Directory) SetManager(id, managerID int) error {
+	m, ok := d.members[id]
+	if !ok {
+		return fmt.Errorf("karyawan #%d: %w", id, ErrEmployeeNotFound)
+	}
+	if _, ok := d.members[managerID]; managerID != 0 && !ok {
+		return fmt.Errorf("manajer #%d: %w", managerID, ErrEmployeeNotFound)
+	}
+
+	old := m.ManagerID
+	d.setManager(m, managerID)
+	if err := d.checkChain(id); err != nil {
+		d.setManager(m, old)
+		return err
+	}
+	return nil
+}
+
+// Update replaces the record of e.ID. The whole change is refused if the
+// new manager would create a reporting cycle.
+func (d *Directory) Update(e Employee, department string, managerID int) error {
+	m, ok := d.members[e.ID]
+	if !ok {
+		return fmt.Errorf("karyawan #%d: %w", e.ID, ErrEmployeeNotFound)
+	}
+	if err := d.SetManager(e.ID, managerID); err != nil {
+		return err
+	}
+	m.Employee = e
+	m.Department = department
+	return nil
+}
+
+// Remove deletes id from the directory. Its direct reports move up to
+// id's own manager, so the rest of the tree stays connected.
+func (d *Directory) Remove(id int) error {
+	m, ok := d.members[id]
+	if !ok {
+		return fmt.Errorf("karyawan #%d: %w", id, ErrEmployeeNotFound)
+	}
+	newManager := m.ManagerID
+	if newManager == id {
+		newManager = 0
+	}
+	for _, rid := range slices.Clone(d.children[id]) {
+		if other, ok := d.members[rid]; ok && rid != id {
+			d.setManager(other, newManager)
+		}
+	}
+	d.unlink(id, m.ManagerID)
+	delete(d.members, id)
+	return nil
+}
+
+func (d *Directory) checkChain(id int) error {
+	seen := map[int]bool{}
+	var path []int
+	for cur := id; cur != 0; {
+		if seen[cur] {
+			start := 0
+			for path[start] != cur {
+				start++
+			}
+			return &CycleError{IDs: append(path[start:], cur)}
+		}
+		seen[cur] = true
+		path = append(path, cur)
+		m, ok := d.members[cur]
+		if !ok {
+			break
+		}
+		cur = m.ManagerID
+	}
+	return nil
+}
+
+// Validate reports every reporting cycle and every reference to a manager
+// that is not in the directory. CSV files are loaded without these checks
+// so that rows may appear in any order.
+func (d *Directory) Validate() error {
+	var errs []error
+	reported := map[int]bool{}
+	for _, id := range d.ids() {
+		m := d.members[id]
+		if _, ok := d.members[m.ManagerID]; m.ManagerID != 0 && !ok {
+			errs = append(errs, fmt.Errorf("karyawan #%d melapor ke #%d: %w", id, m.ManagerID, ErrEmployeeNotFound))
+		}
+		err := d.checkChain(id)
+		var cycle *CycleError
+		if errors.As(err, &cycle) && !reported[cycle.IDs[0]] {
+			for _, cid := range cycle.IDs {
+				reported[cid] = true
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *Directory) ids() []int {
+	ids := make([]int, 0, len(d.members))
+	for id := range d.members {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func (d *Directory) Roots() []Employee {
+	var roots []Employee
+	for _, id := range d.ids() {
+		m := d.members[id]
+		if _, ok := d.members[m.ManagerID]; m.ManagerID == 0 || !ok {
+			roots = append(roots, m.Employee)
+		}
+	}
+	return roots
+}
+
+func (d *Directory) DirectReports(id int) []Employee {
+	var reports []Employee
+	for _, rid := range d.children[id] {
+		if rid != id {
+			reports = append(reports, d.members[rid].Employee)
+		}
+	}
+	return reports
+}
+
+func (d *Directory) AllReports(id int) []Employee {
+	return slices.Collect(d.Reports(id))
+}
+
+// All yields every employee in ID order.
+func (d *Directory) All() iter.Seq2[int, Employee] {
+	return func(yield func(int, Employee) bool) {
+		for _, id := range d.ids() {
+			if !yield(id, d.members[id].Employee) {
+				return
+			}
+		}
+	}
+}
+
+// Reports walks everyone under id breadth-first, nearest level first.
+// The walk is lazy: a loop that stops after the first match never looks
+// at the lower levels of the tree.
+func (d *Directory) Reports(id int) iter.Seq[Employee] {
+	return func(yield func(Employee) bool) {
+		seen := map[int]bool{id: true}
+		queue := []int{id}
+		for len(queue) > 0 {
+			cur := queue[0]
+			queue = queue[1:]
+			for _, e := range d.DirectReports(cur) {
+				if seen[e.ID] {
+					continue
+				}
+				seen[e.ID] = true
+				if !yield(e) {
+					return
+				}
+				queue = append(queue, e.ID)
+			}
+		}
+	}
+}
+
+func (d *Directory) ReportingChain(id int) []Employee {
+	var chain []Employee
+	seen := map[int]bool{}
+	for cur := id; cur != 0 && !seen[cur]; {
+		seen[cur] = true
+		m, ok := d.members[cur]
+		if !ok {
+			break
+		}
+		chain = append(chain, m.Employee)
+		cur = m.ManagerID
+	}
+	return chain
+}
+
+// SpanOfControl lists everyone with at least minDirect direct reports,
+// widest span first.
+func (d *Directory) SpanOfControl(minDirect int) []SpanOfControl {
+	var spans []SpanOfControl
+	for _, id := range d.ids() {
+		direct := len(d.DirectReports(id))
+		if direct == 0 || direct < minDirect {
+			continue
+		}
+		spans = append(spans, SpanOfControl{
+			Employee: d.members[id].Employee,
+			Direct:   direct,
+			Total:    len(d.AllReports(id)),
+		})
+	}
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].Direct > spans[j].Direct })
+	return spans
+}
+
+func (d *Directory) DepartmentRollup() []DepartmentStats {
+	byDept := map[string]*DepartmentStats{}
+	for _, id := range d.ids() {
+		m := d.members[id]
+		s, ok := byDept[m.Department]
+		if !ok {
+			s = &DepartmentStats{Department: m.Department}
+			byDept[m.Department] = s
+		}
+		s.Headcount++
+		s.TotalSalary += m.Employee.Salary
+		if m.Employee.IsActive {
+			s.Active++
+		}
+	}
+
+	stats := make([]DepartmentStats, 0, len(byDept))
+	for _, s := range byDept {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Department < stats[j].Department })
+	return stats
+}
+
+func (d *Directory) levelOf(id int, seen map[int]bool) int {
+	if seen[id] {
+		return 0
+	}
+	seen[id] = true
+	level := 0
+	for _, e := range d.DirectReports(id) {
+		level = max(level, d.levelOf(e.ID, seen)+1)
+	}
+	return level
+}
+
+// ManagerFor returns the Manager view of an employee who has reports.
+// Level counts how many layers of the organisation sit below them.
+func (d *Directory) ManagerFor(id int) (Manager, bool) {
+	m, ok := d.members[id]
+	if !ok || len(d.DirectReports(id)) == 0 {
+		return Manager{}, false
+	}
+	return Manager{
+		Person:     Person{FirstName: m.Employee.FirstName, LastName: m.Employee.LastName},
+		Department: m.Department,
+		Level:      d.levelOf(id, map[int]bool{}),
+	}, true
+}
+
+func employeeLabel(e Employee) string {
+	label := fmt.Sprintf("%s %s (%s)", e.FirstName, e.LastName, e.Position)
+	if !e.IsActive {
+		label += " [nonaktif]"
+	}
+	return label
+}
+
+func (d *Directory) RenderTree(w io.Writer) {
+	for _, root := range d.Roots() {
+		fmt.Fprintf(w, "%s\n", employeeLabel(root))
+		d.renderSubtree(w, root.ID, "", map[int]bool{root.ID: true})
+	}
+}
+
+func (d *Directory) renderSubtree(w io.Writer, id int, prefix string, seen map[int]bool) {
+	reports := d.DirectReports(id)
+	for i, e := range reports {
+		branch, next := "├── ", "│   "
+		if i == len(reports)-1 {
+			branch, next = "└── ", "    "
+		}
+		if seen[e.ID] {
+			fmt.Fprintf(w, "%s%s%s (siklus)\n", prefix, branch, employeeLabel(e))
+			continue
+		}
+		seen[e.ID] = true
+		fmt.Fprintf(w, "%s%s%s\n", prefix, branch, employeeLabel(e))
+		d.renderSubtree(w, e.ID, prefix+next, seen)
+	}
+}
+
+func (d *Directory) RenderDOT(w io.Writer) {
+	fmt.Fprintln(w, "digraph org {")
+	fmt.Fprintln(w, "  rankdir=TB;")
+	fmt.Fprintln(w, "  node [shape=box, style=rounded];")
+
+	byDept := map[string][]int{}
+	var depts []string
+	for _, id := range d.ids() {
+		dept := d.members[id].Department
+		if _, ok := byDept[dept]; !ok {
+			depts = append(depts, dept)
+		}
+		byDept[dept] = append(byDept[dept], id)
+	}
+	sort.Strings(depts)
+
+	for i, dept := range depts {
+		fmt.Fprintf(w, "  subgraph cluster_%d {\n    label=%q;\n", i, dept)
+		for _, id := range byDept[dept] {
+			e := d.members[id].Employee
+			style := ""
+			if !e.IsActive {
+				style = ", style=\"rounded,dashed\""
+			}
+			fmt.Fprintf(w, "    e%d [label=%q%s];\n", id, e.FirstName+" "+e.LastName+"\n"+e.Position, style)
+		}
+		fmt.Fprintln(w, "  }")
+	}
+	for _, id := range d.ids() {
+		if m := d.members[id]; m.ManagerID != 0 {
+			if _, ok := d.members[m.ManagerID]; ok {
+				fmt.Fprintf(w, "  e%d -> e%d;\n", m.ManagerID, id)
+			}
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// LoadDirectoryCSV reads id, first_name, last_name, position, salary,
+// active, department and manager_id columns (in any order), plus optional
+// hire_date and termination_date in YYYY-MM-DD form. The result is
+// not validated; call Validate to find cycles and dangling managers.
+func LoadDirectoryCSV(r io.Reader) (*Directory, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("csv: gagal membaca header: %w", err)
+	}
+	col := map[string]int{}
+	for i, name := range header {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"id", "first_name", "position", "salary"} {
+		if _, ok := col[required]; !ok {
+			return nil, fmt.Errorf("csv: kolom %q wajib ada", required)
+		}
+	}
+
+	d := NewDirectory()
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csv: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		get := func(name string) string {
+			if i, ok := col[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		id, err := strconv.Atoi(get("id"))
+		if err != nil {
+			return nil, fmt.Errorf("csv baris %d: id tidak valid %q", line, get("id"))
+		}
+		salary, err := strconv.ParseFloat(get("salary"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("csv baris %d: gaji tidak valid %q", line, get("salary"))
+		}
+		if !(salary >= 0 && salary <= MaxSalary) {
+			return nil, fmt.Errorf("csv baris %d: gaji di luar jangkauan %q", line, get("salary"))
+		}
+		managerID := 0
+		if s := get("manager_id"); s != "" {
+			if managerID, err = strconv.Atoi(s); err != nil {
+				return nil, fmt.Errorf("csv baris %d: manager_id tidak valid %q", line, s)
+			}
+		}
+		active := true
+		if s := get("active"); s != "" {
+			if active, err = strconv.ParseBool(s); err != nil {
+				return nil, fmt.Errorf("csv baris %d: active tidak valid %q", line, s)
+			}
+		}
+		var dates [2]time.Time
+		for i, name := range []string{"hire_date", "termination_date"} {
+			if s := get(name); s != "" {
+				if dates[i], err = time.Parse(time.DateOnly, s); err != nil {
+					return nil, fmt.Errorf("csv baris %d: %s tidak valid %q", line, name, s)
+				}
+			}
+		}
+
+		if _, exists := d.members[id]; exists {
+			return nil, fmt.Errorf("csv baris %d: karyawan #%d: %w", line, id, ErrDuplicateEmployee)
+		}
+		d.link(id, managerID)
+		d.members[id] = &OrgMember{
+			Employee: Employee{
+				ID:        id,
+				FirstName: get("first_name"),
+				LastName:  get("last_name"),
+				Position:  get("position"),
+				Salary:    salary,
+				IsActive:  active,
+			},
+			Department:      get("department"),
+			ManagerID:       managerID,
+			HireDate:        dates[0],
+			TerminationDate: dates[1],
+		}
+	}
+	return d, nil
+}
+
+func LoadDirectoryFile(path string) (*Directory, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	d, err := LoadDirectoryCSV(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return d, nil
+}
+
+func printDepartmentRollup(d *Directory) {
+	logf("%-12s %9s %7s %16s %8s\n", "Departemen", "Headcount", "Aktif", "Total Gaji", "Rasio")
+	for _, s := range d.DepartmentRollup() {
+		logf("%-12s %9d %7d %16.2f %7.0f%%\n", s.Department, s.Headcount, s.Active, s.TotalSalary, s.ActiveRatio()*100)
+	}
+}
+
+func orgChartCommand(args []string) error {
+	fs := flag.NewFlagSet("orgchart", flag.ContinueOnError)
+	format := fs.String("format", "text", "text, dot atau stats")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+
+	d, err := LoadDirectoryFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	switch *format {
+	case "text":
+		d.RenderTree(os.Stdout)
+	case "dot":
+		d.RenderDOT(os.Stdout)
+	case "stats":
+		printDepartmentRollup(d)
+		logln("\nRentang kendali (span of control):")
+		for _, s := range d.SpanOfControl(1) {
+			logf("  %-30s langsung: %d, total: %d\n", employeeLabel(s.Employee), s.Direct, s.Total)
+		}
+	default:
+		return fmt.Errorf("%w: format tidak dikenal %q", errUsage, *format)
+	}
+	return nil
+}
+
+func orgChartExample() {
+	d, err := LoadDirectoryCSV(strings.NewReader(sampleEmployeesCSV))
+	if err != nil {
+		logln("Gagal memuat direktori:", err)
+		return
+	}
+
+	var tree strings.Builder
+	d.RenderTree(&tree)
+	logf("%s", tree.String())
+
+	if m, ok := d.ManagerFor(2); ok {
+		logln()
+		m.DelegateTask()
+		logln("Level manajer:", m.Level)
+	}
+
+	logln()
+	printDepartmentRollup(d)
+
+	err = d.SetManager(1, 5)
+	logln("\nMenjadikan CEO bawahan staf:", err)
+	var cycle *CycleError
+	logln("Terdeteksi sebagai siklus?", errors.As(err, &cycle))
+}
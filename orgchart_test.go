@@ -0,0 +1,167 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func loadSampleDirectory(t *testing.T) *Directory {
+	t.Helper()
+	d, err := LoadDirectoryCSV(strings.NewReader(sampleEmployeesCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func employeeIDs(employees []Employee) []int {
+	ids := make([]int, len(employees))
+	for i, e := range employees {
+		ids[i] = e.ID
+	}
+	return ids
+}
+
+func TestSetManagerRejectsCycles(t *testing.T) {
+	d := loadSampleDirectory(t)
+	tests := []struct {
+		id, manager int
+		want        []int
+	}{
+		{1, 1, []int{1, 1}},
+		{1, 5, []int{1, 5, 4, 2, 1}},
+		{2, 4, []int{2, 4, 2}},
+	}
+	for _, tt := range tests {
+		err := d.SetManager(tt.id, tt.manager)
+		var cycle *CycleError
+		if !errors.As(err, &cycle) {
+			t.Errorf("SetManager(%d, %d) = %v, mau CycleError", tt.id, tt.manager, err)
+			continue
+		}
+		if !slices.Equal(cycle.IDs, tt.want) {
+			t.Errorf("SetManager(%d, %d) siklus %v, mau %v", tt.id, tt.manager, cycle.IDs, tt.want)
+		}
+		if m, _ := d.Get(tt.id); m.ManagerID == tt.manager {
+			t.Errorf("SetManager(%d, %d) yang ditolak tetap mengubah manajer", tt.id, tt.manager)
+		}
+	}
+	if got := employeeIDs(d.DirectReports(4)); !slices.Equal(got, []int{5, 6, 7}) {
+		t.Errorf("bawahan #4 setelah perubahan ditolak %v, mau [5 6 7]", got)
+	}
+}
+
+func TestValidateReportsCyclesAndDanglingManagers(t *testing.T) {
+	csvData := "id,first_name,position,salary,manager_id\n" +
+		"1,A,X,1,2\n" +
+		"2,B,X,1,3\n" +
+		"3,C,X,1,1\n" +
+		"4,D,X,1,99\n" +
+		"5,E,X,1,\n"
+	d, err := LoadDirectoryCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.Validate()
+	if !errors.Is(err, ErrEmployeeNotFound) {
+		t.Errorf("Validate() = %v, mau manajer #99 tidak ditemukan", err)
+	}
+	var cycle *CycleError
+	if !errors.As(err, &cycle) || !slices.Equal(cycle.IDs, []int{1, 2, 3, 1}) {
+		t.Errorf("Validate() = %v, mau siklus #1 -> #2 -> #3 -> #1", err)
+	}
+	if n := strings.Count(err.Error(), "siklus"); n != 1 {
+		t.Errorf("siklus yang sama dilaporkan %d kali:\n%v", n, err)
+	}
+}
+
+func TestAddChecksManager(t *testing.T) {
+	d := NewDirectory()
+	if err := d.Add(Employee{ID: 1}, "Direksi", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Add(Employee{ID: 2}, "Teknologi", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Add(Employee{ID: 3}, "Teknologi", 9); !errors.Is(err, ErrEmployeeNotFound) {
+		t.Errorf("Add dengan manajer tak dikenal = %v, mau ErrEmployeeNotFound", err)
+	}
+	var cycle *CycleError
+	if err := d.Add(Employee{ID: 4}, "Teknologi", 4); !errors.As(err, &cycle) {
+		t.Errorf("Add yang melapor ke diri sendiri = %v, mau CycleError", err)
+	}
+	if err := d.Add(Employee{ID: 2}, "Teknologi", 1); !errors.Is(err, ErrDuplicateEmployee) {
+		t.Errorf("Add dengan ID ganda = %v, mau ErrDuplicateEmployee", err)
+	}
+	if got := employeeIDs(d.Roots()); !slices.Equal(got, []int{1}) {
+		t.Errorf("Roots() = %v, mau [1]", got)
+	}
+}
+
+func TestRemoveMovesReportsUp(t *testing.T) {
+	d := loadSampleDirectory(t)
+	if err := d.Remove(4); err != nil {
+		t.Fatal(err)
+	}
+	if got := employeeIDs(d.DirectReports(2)); !slices.Equal(got, []int{5, 6, 7, 8}) {
+		t.Errorf("bawahan #2 setelah #4 dihapus %v, mau [5 6 7 8]", got)
+	}
+	if got := d.DirectReports(4); len(got) != 0 {
+		t.Errorf("#4 yang dihapus masih punya bawahan %v", employeeIDs(got))
+	}
+	if err := d.Remove(4); !errors.Is(err, ErrEmployeeNotFound) {
+		t.Errorf("Remove kedua kali = %v, mau ErrEmployeeNotFound", err)
+	}
+}
+
+func TestReportsAndSpanOfControl(t *testing.T) {
+	d := loadSampleDirectory(t)
+	if got := employeeIDs(d.AllReports(2)); !slices.Equal(got, []int{4, 8, 5, 6, 7}) {
+		t.Errorf("AllReports(2) = %v, mau [4 8 5 6 7] (per level)", got)
+	}
+	var got []string
+	for _, s := range d.SpanOfControl(2) {
+		got = append(got, s.Employee.FirstName)
+		if s.Employee.ID == 1 && (s.Direct != 2 || s.Total != 9) {
+			t.Errorf("span #1 = %d langsung, %d total, mau 2 dan 9", s.Direct, s.Total)
+		}
+	}
+	if want := []string{"Agus", "Rina", "Budi", "Sari"}; !slices.Equal(got, want) {
+		t.Errorf("SpanOfControl(2) = %v, mau %v", got, want)
+	}
+	if m, ok := d.ManagerFor(1); !ok || m.Level != 3 {
+		t.Errorf("ManagerFor(1) = %+v, %v, mau level 3", m, ok)
+	}
+	if _, ok := d.ManagerFor(5); ok {
+		t.Error("ManagerFor(5) berhasil untuk karyawan tanpa bawahan")
+	}
+}
+
+func TestDepartmentRollup(t *testing.T) {
+	d := loadSampleDirectory(t)
+	want := []DepartmentStats{
+		{Department: "Direksi", Headcount: 1, Active: 1, TotalSalary: 85000000},
+		{Department: "Keuangan", Headcount: 3, Active: 3, TotalSalary: 82500000},
+		{Department: "Teknologi", Headcount: 6, Active: 5, TotalSalary: 163000000},
+	}
+	if got := d.DepartmentRollup(); !slices.Equal(got, want) {
+		t.Errorf("DepartmentRollup() =\n  %+v\nmau\n  %+v", got, want)
+	}
+	if r := want[2].ActiveRatio(); r != 5.0/6 {
+		t.Errorf("ActiveRatio() = %v, mau 5/6", r)
+	}
+	if r := (DepartmentStats{}).ActiveRatio(); r != 0 {
+		t.Errorf("ActiveRatio() tanpa karyawan = %v, mau 0", r)
+	}
+}
+
+func TestRenderGoldenOrgChart(t *testing.T) {
+	d := loadSampleDirectory(t)
+	var tree, dot strings.Builder
+	d.RenderTree(&tree)
+	d.RenderDOT(&dot)
+	checkGolden(t, "orgchart_tree.txt", tree.String())
+	checkGolden(t, "orgchart.dot", dot.String())
+}
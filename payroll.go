@@ -0,0 +1,561 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Money is an amount in sen (1/100 Rupiah). Every operation that can
+// produce a fraction of a sen rounds half to even ("banker's rounding"),
+// so results do not drift upwards when many lines are summed.
+type Money int64
+
+type TaxCalculator interface {
+	Tax(taxable Money) (Money, error)
+}
+
+// TaxBracket taxes the part of the annual income up to UpTo at RateBP
+// basis points (1/100 of a percent). The last bracket has UpTo == 0 and
+// is unbounded.
+type TaxBracket struct {
+	UpTo   Money
+	RateBP int64
+}
+
+type ProgressiveTax struct {
+	Name            string
+	Brackets        []TaxBracket
+	AnnualExemption Money
+	PeriodsPerYear  int64
+}
+
+type FlatTax struct {
+	Name   string
+	RateBP int64
+}
+
+// PayComponent is an allowance or a deduction: either a fixed Amount for a
+// full period or RateBP basis points of the (prorated) base salary.
+type PayComponent struct {
+	Name    string
+	Amount  Money
+	RateBP  int64
+	Taxable bool
+	PreTax  bool
+}
+
+type PayPeriod struct {
+	Start, End time.Time
+}
+
+type PayslipLine struct {
+	Name   string `json:"name"`
+	Amount Money  `json:"amount"`
+}
+
+type Payslip struct {
+	EmployeeID   int           `json:"employee_id"`
+	Name         string        `json:"name"`
+	Position     string        `json:"position"`
+	Period       string        `json:"period"`
+	DaysWorked   int64         `json:"days_worked"`
+	DaysInPeriod int64         `json:"days_in_period"`
+	BaseSalary   Money         `json:"base_salary"`
+	Allowances   []PayslipLine `json:"allowances"`
+	Gross        Money         `json:"gross"`
+	Taxable      Money         `json:"taxable"`
+	Tax          Money         `json:"tax"`
+	Deductions   []PayslipLine `json:"deductions"`
+	Net          Money         `json:"net"`
+}
+
+type PayrollEngine struct {
+	Tax        TaxCalculator
+	Allowances []PayComponent
+	Deductions []PayComponent
+}
+
+var (
+	ErrNotOnPayroll  = errors.New("tidak bekerja pada periode ini")
+	ErrMoneyOverflow = errors.New("jumlah uang di luar jangkauan")
+)
+
+// MaxSalary is the largest monthly salary, in Rupiah, that LoadDirectoryCSV
+// accepts. It keeps a year of salary plus allowances far inside a Money,
+// so a payslip only overflows for salaries set in code.
+const MaxSalary = 1_000_000_000_000
+
+// PPh21Progressive follows the annual brackets of UU HPP for a single
+// taxpayer without dependants (PTKP TK/0).
+var PPh21Progressive = ProgressiveTax{
+	Name: "PPh 21",
+	Brackets: []TaxBracket{
+		{UpTo: Rupiah(60_000_000), RateBP: 500},
+		{UpTo: Rupiah(250_000_000), RateBP: 1500},
+		{UpTo: Rupiah(500_000_000), RateBP: 2500},
+		{UpTo: Rupiah(5_000_000_000), RateBP: 3000},
+		{RateBP: 3500},
+	},
+	AnnualExemption: Rupiah(54_000_000),
+	PeriodsPerYear:  12,
+}
+
+func init() {
+	registerCommand(command{
+		Name:  "payroll",
+		Usage: "payroll [-period YYYY-MM] [-format text|json] [-id N] FILE.csv",
+		Run:   payrollCommand,
+	})
+}
+
+func Rupiah(amount int64) Money {
+	return Money(amount * 100)
+}
+
+// MoneyFromFloat converts an amount in Rupiah. NaN, the infinities and
+// amounts outside a Money return ErrMoneyOverflow, since converting them
+// to int64 has no defined result.
+func MoneyFromFloat(f float64) (Money, error) {
+	cents := math.RoundToEven(f * 100)
+	if math.IsNaN(cents) || cents < math.MinInt64 || cents >= math.MaxInt64 {
+		return 0, fmt.Errorf("%v: %w", f, ErrMoneyOverflow)
+	}
+	return Money(cents), nil
+}
+
+func ParseMoney(s string) (Money, error) {
+	clean := strings.TrimPrefix(strings.TrimSpace(s), "Rp")
+	r, ok := new(big.Rat).SetString(clean)
+	if !ok {
+		return 0, fmt.Errorf("jumlah uang tidak valid: %q", s)
+	}
+	r.Mul(r, big.NewRat(100, 1))
+	cents, ok := roundHalfEven(r.Num(), r.Denom())
+	if !ok {
+		return 0, fmt.Errorf("%q: %w", s, ErrMoneyOverflow)
+	}
+	return Money(cents), nil
+}
+
+// roundHalfEven divides num by den, rounding ties to the even neighbour.
+// ok is false when the result does not fit in an int64.
+func roundHalfEven(num, den *big.Int) (cents int64, ok bool) {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	twiceR := new(big.Int).Abs(r)
+	twiceR.Lsh(twiceR, 1)
+	switch twiceR.Cmp(new(big.Int).Abs(den)) {
+	case 1:
+		q.Add(q, big.NewInt(int64(num.Sign()*den.Sign())))
+	case 0:
+		if q.Bit(0) == 1 {
+			q.Add(q, big.NewInt(int64(num.Sign()*den.Sign())))
+		}
+	}
+	if !q.IsInt64() {
+		return 0, false
+	}
+	return q.Int64(), true
+}
+
+// MulRat returns m * num / den without intermediate overflow. A result
+// that does not fit in a Money returns ErrMoneyOverflow.
+func (m Money) MulRat(num, den int64) (Money, error) {
+	if den == 0 {
+		return 0, errors.New("Money.MulRat: pembagi nol")
+	}
+	product := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(num))
+	cents, ok := roundHalfEven(product, big.NewInt(den))
+	if !ok {
+		return 0, fmt.Errorf("%d × %d / %d: %w", int64(m), num, den, ErrMoneyOverflow)
+	}
+	return Money(cents), nil
+}
+
+func (m Money) Percent(basisPoints int64) (Money, error) {
+	return m.MulRat(basisPoints, 10_000)
+}
+
+func (m Money) Float() float64 {
+	return float64(m) / 100
+}
+
+// String formats the amount the Indonesian way: Rp1.234.567,50.
+func (m Money) String() string {
+	sign := ""
+	abs := int64(m)
+	if abs < 0 {
+		sign, abs = "-", -abs
+	}
+	whole := strconv.FormatInt(abs/100, 10)
+	var sb strings.Builder
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteRune(d)
+	}
+	return fmt.Sprintf("%sRp%s,%02d", sign, sb.String(), abs%100)
+}
+
+func (m Money) decimal() string {
+	sign := ""
+	abs := int64(m)
+	if abs < 0 {
+		sign, abs = "-", -abs
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, abs/100, abs%100)
+}
+
+// MarshalJSON writes a decimal string so that consumers never see the
+// amount as a binary float.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.decimal())
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		s = string(data)
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+func (t ProgressiveTax) annualTax(annual Money) (Money, error) {
+	var tax, lower Money
+	for _, b := range t.Brackets {
+		if annual <= lower {
+			break
+		}
+		upper := annual
+		if b.UpTo != 0 && b.UpTo < annual {
+			upper = b.UpTo
+		}
+		part, err := (upper - lower).Percent(b.RateBP)
+		if err != nil {
+			return 0, err
+		}
+		tax += part
+		if b.UpTo == 0 {
+			break
+		}
+		lower = b.UpTo
+	}
+	return tax, nil
+}
+
+// Tax annualizes one period's taxable income, applies the brackets and
+// spreads the annual tax evenly over the periods again.
+func (t ProgressiveTax) Tax(taxable Money) (Money, error) {
+	periods := t.PeriodsPerYear
+	if periods <= 0 {
+		periods = 1
+	}
+	annual, err := taxable.MulRat(periods, 1)
+	if err != nil {
+		return 0, err
+	}
+	annual -= t.AnnualExemption
+	if annual <= 0 {
+		return 0, nil
+	}
+	tax, err := t.annualTax(annual)
+	if err != nil {
+		return 0, err
+	}
+	return tax.MulRat(1, periods)
+}
+
+func (t ProgressiveTax) String() string {
+	return t.Name
+}
+
+func (t FlatTax) String() string {
+	return t.Name
+}
+
+func (t FlatTax) Tax(taxable Money) (Money, error) {
+	if taxable <= 0 {
+		return 0, nil
+	}
+	return taxable.Percent(t.RateBP)
+}
+
+func MonthlyPeriod(year int, month time.Month) PayPeriod {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return PayPeriod{Start: start, End: start.AddDate(0, 1, -1)}
+}
+
+func ParsePayPeriod(s string) (PayPeriod, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return PayPeriod{}, fmt.Errorf("periode tidak valid %q (format YYYY-MM)", s)
+	}
+	return MonthlyPeriod(t.Year(), t.Month()), nil
+}
+
+func (p PayPeriod) Label() string {
+	return p.Start.Format("2006-01")
+}
+
+func daysBetween(start, end time.Time) int64 {
+	if end.Before(start) {
+		return 0
+	}
+	return int64(end.Sub(start).Hours()/24) + 1
+}
+
+func (p PayPeriod) Days() int64 {
+	return daysBetween(p.Start, p.End)
+}
+
+// employedDays counts the calendar days of the period the employee was on
+// the payroll, both the hire and the termination day included.
+func (p PayPeriod) employedDays(m OrgMember) int64 {
+	start, end := p.Start, p.End
+	if !m.HireDate.IsZero() && m.HireDate.After(start) {
+		start = m.HireDate
+	}
+	if !m.TerminationDate.IsZero() && m.TerminationDate.Before(end) {
+		end = m.TerminationDate
+	}
+	return daysBetween(start, end)
+}
+
+func (c PayComponent) amountFor(base Money, worked, total int64) (Money, error) {
+	if c.RateBP != 0 {
+		return base.Percent(c.RateBP)
+	}
+	return c.Amount.MulRat(worked, total)
+}
+
+func DefaultPayrollEngine() PayrollEngine {
+	return PayrollEngine{
+		Tax: PPh21Progressive,
+		Allowances: []PayComponent{
+			{Name: "Tunjangan Transport", Amount: Rupiah(750_000), Taxable: true},
+			{Name: "Tunjangan Jabatan", RateBP: 500, Taxable: true},
+		},
+		Deductions: []PayComponent{
+			{Name: "BPJS Kesehatan", RateBP: 100, PreTax: true},
+			{Name: "BPJS JHT", RateBP: 200, PreTax: true},
+			{Name: "Iuran Koperasi", Amount: Rupiah(100_000)},
+		},
+	}
+}
+
+func (pe PayrollEngine) Calculate(m OrgMember, period PayPeriod) (Payslip, error) {
+	e := m.Employee
+	if !e.IsActive && m.TerminationDate.IsZero() {
+		return Payslip{}, fmt.Errorf("karyawan #%d nonaktif tanpa tanggal berhenti: %w", e.ID, ErrNotOnPayroll)
+	}
+	worked, total := period.employedDays(m), period.Days()
+	if worked == 0 {
+		return Payslip{}, fmt.Errorf("karyawan #%d: %w", e.ID, ErrNotOnPayroll)
+	}
+
+	salary, err := MoneyFromFloat(e.Salary)
+	if err != nil {
+		return Payslip{}, fmt.Errorf("karyawan #%d: gaji %w", e.ID, err)
+	}
+	base, err := salary.MulRat(worked, total)
+	if err != nil {
+		return Payslip{}, fmt.Errorf("karyawan #%d: gaji %w", e.ID, err)
+	}
+
+	slip := Payslip{
+		EmployeeID:   e.ID,
+		Name:         strings.TrimSpace(e.FirstName + " " + e.LastName),
+		Position:     e.Position,
+		Period:       period.Label(),
+		DaysWorked:   worked,
+		DaysInPeriod: total,
+		BaseSalary:   base,
+	}
+
+	slip.Gross = slip.BaseSalary
+	slip.Taxable = slip.BaseSalary
+	for _, c := range pe.Allowances {
+		amount, err := c.amountFor(slip.BaseSalary, worked, total)
+		if err != nil {
+			return Payslip{}, fmt.Errorf("karyawan #%d: %s %w", e.ID, c.Name, err)
+		}
+		slip.Allowances = append(slip.Allowances, PayslipLine{Name: c.Name, Amount: amount})
+		slip.Gross += amount
+		if c.Taxable {
+			slip.Taxable += amount
+		}
+	}
+
+	var postTax []PayslipLine
+	var deducted Money
+	for _, c := range pe.Deductions {
+		amount, err := c.amountFor(slip.BaseSalary, worked, total)
+		if err != nil {
+			return Payslip{}, fmt.Errorf("karyawan #%d: %s %w", e.ID, c.Name, err)
+		}
+		line := PayslipLine{Name: c.Name, Amount: amount}
+		deducted += amount
+		if c.PreTax {
+			slip.Taxable -= amount
+			slip.Deductions = append(slip.Deductions, line)
+		} else {
+			postTax = append(postTax, line)
+		}
+	}
+
+	if pe.Tax != nil {
+		if slip.Tax, err = pe.Tax.Tax(slip.Taxable); err != nil {
+			return Payslip{}, fmt.Errorf("karyawan #%d: pajak %w", e.ID, err)
+		}
+	}
+	taxName := "Pajak"
+	if named, ok := pe.Tax.(fmt.Stringer); ok {
+		taxName = named.String()
+	}
+	slip.Deductions = append(slip.Deductions, PayslipLine{Name: taxName, Amount: slip.Tax})
+	slip.Deductions = append(slip.Deductions, postTax...)
+
+	slip.Net = slip.Gross - deducted - slip.Tax
+	return slip, nil
+}
+
+func (pe PayrollEngine) RunDirectory(d *Directory, period PayPeriod) ([]Payslip, []error) {
+	var slips []Payslip
+	var skipped []error
+	for _, id := range d.ids() {
+		slip, err := pe.Calculate(*d.members[id], period)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+		slips = append(slips, slip)
+	}
+	return slips, skipped
+}
+
+func (p Payslip) Text() string {
+	var sb strings.Builder
+	line := strings.Repeat("-", 52)
+	row := func(label string, m Money) {
+		fmt.Fprintf(&sb, "  %-28s %20s\n", label, m)
+	}
+
+	fmt.Fprintf(&sb, "SLIP GAJI %s\n%s\n", p.Period, line)
+	fmt.Fprintf(&sb, "  #%d %s - %s\n", p.EmployeeID, p.Name, p.Position)
+	if p.DaysWorked != p.DaysInPeriod {
+		fmt.Fprintf(&sb, "  Prorata: %d dari %d hari\n", p.DaysWorked, p.DaysInPeriod)
+	}
+	sb.WriteString(line + "\n")
+	row("Gaji Pokok", p.BaseSalary)
+	for _, a := range p.Allowances {
+		row(a.Name, a.Amount)
+	}
+	row("Total Bruto", p.Gross)
+	sb.WriteString(line + "\n")
+	for _, d := range p.Deductions {
+		row(d.Name, -d.Amount)
+	}
+	sb.WriteString(line + "\n")
+	row("Gaji Bersih", p.Net)
+	return sb.String()
+}
+
+func payrollCommand(args []string) error {
+	fs := flag.NewFlagSet("payroll", flag.ContinueOnError)
+	periodFlag := fs.String("period", time.Now().Format("2006-01"), "periode gaji YYYY-MM")
+	format := fs.String("format", "text", "text atau json")
+	only := fs.Int("id", 0, "hanya karyawan dengan ID ini")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+	period, err := ParsePayPeriod(*periodFlag)
+	if err != nil {
+		return err
+	}
+	d, err := LoadDirectoryFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	slips, skipped := DefaultPayrollEngine().RunDirectory(d, period)
+	if *only != 0 {
+		var filtered []Payslip
+		for _, s := range slips {
+			if s.EmployeeID == *only {
+				filtered = append(filtered, s)
+			}
+		}
+		slips = filtered
+	}
+
+	switch *format {
+	case "text":
+		for _, s := range slips {
+			fmt.Println(s.Text())
+		}
+		for _, err := range skipped {
+			fmt.Println("Dilewati:", err)
+		}
+	case "json":
+		data, err := json.MarshalIndent(slips, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		return fmt.Errorf("%w: format tidak dikenal %q", errUsage, *format)
+	}
+	return nil
+}
+
+func payrollExample() {
+	x, y := 0.1, 0.2
+	logln("float64: 0.1 + 0.2 =", x+y)
+	a, _ := ParseMoney("0.10")
+	b, _ := ParseMoney("0.20")
+	logln("Money:   0.10 + 0.20 =", a+b)
+
+	half, _ := ParseMoney("0.005")
+	threeHalves, _ := ParseMoney("0.015")
+	logln("Pembulatan half-even: 0,005 ->", half, "dan 0,015 ->", threeHalves)
+
+	d, err := LoadDirectoryCSV(strings.NewReader(sampleEmployeesCSV))
+	if err != nil {
+		logln("Gagal memuat karyawan:", err)
+		return
+	}
+	period := MonthlyPeriod(2026, time.October)
+	engine := DefaultPayrollEngine()
+
+	for _, id := range []int{5, 8} {
+		m, _ := d.Get(id)
+		slip, err := engine.Calculate(m, period)
+		if err != nil {
+			logln("Error:", err)
+			continue
+		}
+		logln()
+		logf("%s", slip.Text())
+	}
+
+	m, _ := d.Get(7)
+	slip, _ := engine.Calculate(m, period)
+	data, _ := json.Marshal(slip)
+	logln("\nSlip JSON (karyawan berhenti tengah bulan):")
+	logln(string(data))
+}
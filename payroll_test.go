@@ -0,0 +1,206 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRoundHalfEven(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		want     int64
+	}{
+		{1, 2, 0},   // 0,5
+		{3, 2, 2},   // 1,5
+		{5, 2, 2},   // 2,5
+		{7, 2, 4},   // 3,5
+		{-1, 2, 0},  // -0,5
+		{-3, 2, -2}, // -1,5
+		{-5, 2, -2}, // -2,5
+		{5, -2, -2}, // penyebut negatif
+		{7, 3, 2},
+		{8, 3, 3},
+		{-8, 3, -3},
+	}
+	for _, tt := range tests {
+		got, ok := roundHalfEven(big.NewInt(tt.num), big.NewInt(tt.den))
+		if !ok || got != tt.want {
+			t.Errorf("roundHalfEven(%d, %d) = %d, %v, mau %d", tt.num, tt.den, got, ok, tt.want)
+		}
+	}
+
+	huge := new(big.Int).Lsh(big.NewInt(1), 70)
+	if _, ok := roundHalfEven(huge, big.NewInt(1)); ok {
+		t.Error("2^70 tidak dilaporkan di luar jangkauan")
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Money
+	}{
+		{"0.005", 0},
+		{"0.015", 2},
+		{"0.025", 2},
+		{"-0.005", 0},
+		{"-0.015", -2},
+		{"Rp1234.5", 123450},
+		{" 10 ", 1000},
+	}
+	for _, tt := range tests {
+		got, err := ParseMoney(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMoney(%q) = %d, %v, mau %d", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"", "abc", "1e30", "-92233720368547758.09"} {
+		if _, err := ParseMoney(in); err == nil {
+			t.Errorf("ParseMoney(%q) tidak menghasilkan error", in)
+		}
+	}
+}
+
+func TestMulRatOverflow(t *testing.T) {
+	if _, err := Money(math.MaxInt64).MulRat(2, 1); !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("MulRat yang meluap = %v, mau ErrMoneyOverflow", err)
+	}
+	if _, err := Money(1).MulRat(1, 0); err == nil {
+		t.Error("MulRat dengan pembagi nol tidak menghasilkan error")
+	}
+	if got, err := Money(math.MaxInt64).MulRat(1, 2); err != nil || got != Money(1<<62) {
+		t.Errorf("MulRat(1, 2) dari MaxInt64 = %d, %v, mau 2^62", got, err)
+	}
+}
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want Money
+	}{
+		{0, 0},
+		{0.125, 12}, // 12,5 sen dibulatkan ke genap
+		{-0.5, -50},
+		{90_000_000_000_000_000, 9_000_000_000_000_000_000},
+	}
+	for _, tt := range tests {
+		if got, err := MoneyFromFloat(tt.in); err != nil || got != tt.want {
+			t.Errorf("MoneyFromFloat(%v) = %d, %v, mau %d", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e17, -1e17} {
+		if _, err := MoneyFromFloat(in); !errors.Is(err, ErrMoneyOverflow) {
+			t.Errorf("MoneyFromFloat(%v) = %v, mau ErrMoneyOverflow", in, err)
+		}
+	}
+}
+
+func TestCalculateHugeSalary(t *testing.T) {
+	m := OrgMember{Employee: Employee{ID: 1, FirstName: "Andi", Salary: 90_000_000_000_000_000, IsActive: true}}
+	_, err := DefaultPayrollEngine().Calculate(m, MonthlyPeriod(2026, time.October))
+	if !errors.Is(err, ErrMoneyOverflow) {
+		t.Errorf("Calculate dengan gaji 9e16 = %v, mau ErrMoneyOverflow", err)
+	}
+
+	for _, salary := range []string{"90000000000000000", "-1", "NaN", "Inf"} {
+		csvData := "id,first_name,position,salary\n1,Andi,CEO," + salary + "\n"
+		if _, err := LoadDirectoryCSV(strings.NewReader(csvData)); err == nil {
+			t.Errorf("LoadDirectoryCSV menerima gaji %s", salary)
+		}
+	}
+}
+
+func date(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestCalculateProration(t *testing.T) {
+	// Februari 2024 punya 29 hari kalender.
+	period := MonthlyPeriod(2024, time.February)
+	tests := []struct {
+		name        string
+		hire, term  string
+		active      bool
+		worked      int64
+		wantBase    Money
+		wantNotPaid bool
+	}{
+		{"sebulan penuh", "2020-01-01", "", true, 29, Rupiah(2_900_000), false},
+		{"masuk tanggal 10", "2024-02-10", "", true, 20, Rupiah(2_000_000), false},
+		{"masuk hari terakhir", "2024-02-29", "", true, 1, Rupiah(100_000), false},
+		{"berhenti tanggal 15", "2020-01-01", "2024-02-15", false, 15, Rupiah(1_500_000), false},
+		{"berhenti hari pertama", "2020-01-01", "2024-02-01", false, 1, Rupiah(100_000), false},
+		{"masuk dan berhenti", "2024-02-10", "2024-02-15", false, 6, Rupiah(600_000), false},
+		{"masuk bulan depan", "2024-03-01", "", true, 0, 0, true},
+		{"berhenti bulan lalu", "2020-01-01", "2024-01-31", false, 0, 0, true},
+	}
+	for _, tt := range tests {
+		m := OrgMember{
+			Employee: Employee{ID: 1, FirstName: "Andi", Salary: 2_900_000, IsActive: tt.active},
+			HireDate: date(tt.hire),
+		}
+		if tt.term != "" {
+			m.TerminationDate = date(tt.term)
+		}
+		slip, err := PayrollEngine{}.Calculate(m, period)
+		if tt.wantNotPaid {
+			if !errors.Is(err, ErrNotOnPayroll) {
+				t.Errorf("%s: error %v, mau ErrNotOnPayroll", tt.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if slip.DaysWorked != tt.worked || slip.DaysInPeriod != 29 {
+			t.Errorf("%s: %d/%d hari, mau %d/29", tt.name, slip.DaysWorked, slip.DaysInPeriod, tt.worked)
+		}
+		if slip.BaseSalary != tt.wantBase {
+			t.Errorf("%s: gaji pokok %v, mau %v", tt.name, slip.BaseSalary, tt.wantBase)
+		}
+	}
+}
+
+func TestProgressiveTaxBrackets(t *testing.T) {
+	tests := []struct {
+		annual Money
+		want   Money
+	}{
+		{0, 0},
+		{Rupiah(60_000_000), Rupiah(3_000_000)},
+		{Rupiah(60_000_001), Rupiah(3_000_000) + 15},
+		{Rupiah(250_000_000), Rupiah(31_500_000)},
+		{Rupiah(250_000_001), Rupiah(31_500_000) + 25},
+		{Rupiah(500_000_000), Rupiah(94_000_000)},
+		{Rupiah(5_000_000_000), Rupiah(1_444_000_000)},
+		{Rupiah(5_000_000_100), Rupiah(1_444_000_035)},
+	}
+	for _, tt := range tests {
+		if got, err := PPh21Progressive.annualTax(tt.annual); err != nil || got != tt.want {
+			t.Errorf("annualTax(%v) = %v, %v, mau %v", tt.annual, got, err, tt.want)
+		}
+	}
+
+	monthly := []struct {
+		taxable Money
+		want    Money
+	}{
+		{Rupiah(4_500_000), 0}, // tepat PTKP setahun
+		{Rupiah(1_000_000), 0},
+		{Rupiah(10_000_000), Rupiah(325_000)},
+	}
+	for _, tt := range monthly {
+		if got, err := PPh21Progressive.Tax(tt.taxable); err != nil || got != tt.want {
+			t.Errorf("Tax(%v) = %v, %v, mau %v", tt.taxable, got, err, tt.want)
+		}
+	}
+}
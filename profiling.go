@@ -0,0 +1,539 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	httppprof "net/http/pprof"
+	"os"
+	"os/signal"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Section 14 of the README points at runtime/pprof and go tool pprof.
+// The lesson runner wires them in: the profiling flags wrap the chosen
+// lessons, and once the run is over the written profiles are read back
+// and summarised as a top-N table, so a first look needs no other tool.
+// The files are ordinary pprof profiles and open in go tool pprof too.
+
+type ProfileOptions struct {
+	CPUProfile   string
+	MemProfile   string
+	BlockProfile string
+	MutexProfile string
+	Trace        string
+	Top          int // rows per summary, 0 for none
+}
+
+// ProfileEntry is one function in a profile summary. Flat counts the
+// samples where the function itself was running (or blocking, or
+// allocating); Cum also counts the ones where it was further up the stack.
+type ProfileEntry struct {
+	Func string
+	Flat int64
+	Cum  int64
+}
+
+// Profile is the part of a pprof profile.proto needed for a top-N
+// summary: sample types, samples and the functions behind their stacks.
+type Profile struct {
+	SampleTypes []string // "type/unit", such as "cpu/nanoseconds"
+	samples     []profileSample
+	locations   map[uint64][]uint64 // location ID -> function IDs, innermost first
+	functions   map[uint64]string
+}
+
+type profileSample struct {
+	locations []uint64 // leaf first
+	values    []int64
+}
+
+var ErrProfileFormat = errors.New("format profil tidak valid")
+
+type profileSession struct {
+	opts    ProfileOptions
+	cpu     *os.File
+	trace   *os.File
+	stopped bool
+}
+
+func (o ProfileOptions) enabled() bool {
+	return o.CPUProfile != "" || o.MemProfile != "" || o.BlockProfile != "" || o.MutexProfile != "" || o.Trace != ""
+}
+
+func startProfiling(opts ProfileOptions) (*profileSession, error) {
+	s := &profileSession{opts: opts}
+	if opts.BlockProfile != "" {
+		runtime.SetBlockProfileRate(1)
+	}
+	if opts.MutexProfile != "" {
+		runtime.SetMutexProfileFraction(1)
+	}
+	if opts.CPUProfile != "" {
+		f, err := os.Create(opts.CPUProfile)
+		if err != nil {
+			return nil, fmt.Errorf("gagal membuat profil CPU: %w", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			s.abort()
+			return nil, fmt.Errorf("gagal memulai profil CPU: %w", err)
+		}
+		s.cpu = f
+	}
+	if opts.Trace != "" {
+		f, err := os.Create(opts.Trace)
+		if err != nil {
+			s.abort()
+			return nil, fmt.Errorf("gagal membuat file trace: %w", err)
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			s.abort()
+			return nil, fmt.Errorf("gagal memulai trace: %w", err)
+		}
+		s.trace = f
+	}
+	return s, nil
+}
+
+// abort undoes what startProfiling has started so far. Unlike Stop it
+// writes no profiles: a session that never ran has nothing to report, and
+// an empty memory profile would overwrite a good one from an earlier run.
+func (s *profileSession) abort() {
+	if s.cpu != nil {
+		pprof.StopCPUProfile()
+		s.cpu.Close()
+		s.cpu = nil
+	}
+	if s.opts.BlockProfile != "" {
+		runtime.SetBlockProfileRate(0)
+	}
+	if s.opts.MutexProfile != "" {
+		runtime.SetMutexProfileFraction(0)
+	}
+}
+
+// Stop ends the CPU profile and the trace and writes the profiles that
+// are snapshots (memory, block, mutex). Only the first call does
+// anything.
+func (s *profileSession) Stop() error {
+	if s.stopped {
+		return nil
+	}
+	s.stopped = true
+	var errs []error
+	if s.cpu != nil {
+		pprof.StopCPUProfile()
+		errs = append(errs, s.cpu.Close())
+		s.cpu = nil
+	}
+	if s.trace != nil {
+		trace.Stop()
+		errs = append(errs, s.trace.Close())
+		s.trace = nil
+	}
+	if s.opts.MemProfile != "" {
+		runtime.GC() // bring the allocation statistics up to date
+		errs = append(errs, writeProfile("allocs", s.opts.MemProfile))
+	}
+	if s.opts.BlockProfile != "" {
+		errs = append(errs, writeProfile("block", s.opts.BlockProfile))
+		runtime.SetBlockProfileRate(0)
+	}
+	if s.opts.MutexProfile != "" {
+		errs = append(errs, writeProfile("mutex", s.opts.MutexProfile))
+		runtime.SetMutexProfileFraction(0)
+	}
+	return errors.Join(errs...)
+}
+
+func writeProfile(name, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("gagal membuat profil %s: %w", name, err)
+	}
+	if err := pprof.Lookup(name).WriteTo(f, 0); err != nil {
+		f.Close()
+		return fmt.Errorf("gagal menulis profil %s: %w", name, err)
+	}
+	return f.Close()
+}
+
+// WriteSummaries prints a top-N table for every profile that was written.
+func (s *profileSession) WriteSummaries(w io.Writer) {
+	if s.opts.Top <= 0 {
+		return
+	}
+	summaries := []struct {
+		title, path, sampleType string
+	}{
+		{"CPU", s.opts.CPUProfile, "cpu"},
+		{"Memori (total alokasi)", s.opts.MemProfile, "alloc_space"},
+		{"Block", s.opts.BlockProfile, "delay"},
+		{"Mutex", s.opts.MutexProfile, "delay"},
+	}
+	for _, sum := range summaries {
+		if sum.path == "" {
+			continue
+		}
+		p, err := ReadProfile(sum.path)
+		if err == nil {
+			err = p.WriteTop(w, sum.title+" ("+sum.path+")", sum.sampleType, s.opts.Top)
+		}
+		if err != nil {
+			fmt.Fprintf(w, "Profil %s: %v\n", sum.title, err)
+		}
+		fmt.Fprintln(w)
+	}
+	if s.opts.Trace != "" {
+		fmt.Fprintf(w, "Trace ditulis ke %s; buka dengan: go tool trace %s\n", s.opts.Trace, s.opts.Trace)
+	}
+}
+
+func ReadProfile(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(zr); err != nil {
+			return nil, err
+		}
+	}
+	return ParseProfile(data)
+}
+
+// ParseProfile decodes the profile.proto message. Only the fields used
+// here are read:
+//
+//	Profile  1: sample_type  2: sample  4: location  5: function  6: string_table
+//	ValueType 1: type  2: unit
+//	Sample    1: location_id (packed)  2: value (packed)
+//	Location  1: id  4: line
+//	Line      1: function_id
+//	Function  1: id  2: name
+func ParseProfile(data []byte) (*Profile, error) {
+	p := &Profile{locations: map[uint64][]uint64{}, functions: map[uint64]string{}}
+	var strs []string
+	var types [][2]uint64
+	funcNames := map[uint64]uint64{}
+
+	err := protoFields(data, func(num int, v uint64, b []byte) error {
+		switch num {
+		case 1:
+			var t [2]uint64
+			err := protoFields(b, func(num int, v uint64, _ []byte) error {
+				if num == 1 || num == 2 {
+					t[num-1] = v
+				}
+				return nil
+			})
+			types = append(types, t)
+			return err
+		case 2:
+			var s profileSample
+			err := protoFields(b, func(num int, v uint64, b []byte) error {
+				var err error
+				switch num {
+				case 1:
+					s.locations, err = appendVarints(s.locations, v, b)
+				case 2:
+					var vals []uint64
+					vals, err = appendVarints(nil, v, b)
+					for _, x := range vals {
+						s.values = append(s.values, int64(x))
+					}
+				}
+				return err
+			})
+			p.samples = append(p.samples, s)
+			return err
+		case 4:
+			var id uint64
+			var funcs []uint64
+			err := protoFields(b, func(num int, v uint64, b []byte) error {
+				switch num {
+				case 1:
+					id = v
+				case 4:
+					return protoFields(b, func(num int, v uint64, _ []byte) error {
+						if num == 1 {
+							funcs = append(funcs, v)
+						}
+						return nil
+					})
+				}
+				return nil
+			})
+			p.locations[id] = funcs
+			return err
+		case 5:
+			var id, name uint64
+			err := protoFields(b, func(num int, v uint64, _ []byte) error {
+				switch num {
+				case 1:
+					id = v
+				case 2:
+					name = v
+				}
+				return nil
+			})
+			funcNames[id] = name
+			return err
+		case 6:
+			strs = append(strs, string(b))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	str := func(i uint64) string {
+		if i < uint64(len(strs)) {
+			return strs[i]
+		}
+		return "?"
+	}
+	for _, t := range types {
+		p.SampleTypes = append(p.SampleTypes, str(t[0])+"/"+str(t[1]))
+	}
+	for id, name := range funcNames {
+		p.functions[id] = str(name)
+	}
+	return p, nil
+}
+
+// protoFields calls fn for every field of a protobuf message: v holds
+// varint and fixed-size values, b the bytes of length-delimited ones.
+func protoFields(data []byte, fn func(num int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrProfileFormat
+		}
+		data = data[n:]
+		num := int(key >> 3)
+		var v uint64
+		var b []byte
+		switch key & 7 {
+		case 0:
+			if v, n = binary.Uvarint(data); n <= 0 {
+				return ErrProfileFormat
+			}
+			data = data[n:]
+		case 1:
+			if len(data) < 8 {
+				return ErrProfileFormat
+			}
+			v, data = binary.LittleEndian.Uint64(data), data[8:]
+		case 2:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return ErrProfileFormat
+			}
+			b, data = data[n:n+int(l)], data[n+int(l):]
+		case 5:
+			if len(data) < 4 {
+				return ErrProfileFormat
+			}
+			v, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		default:
+			return ErrProfileFormat
+		}
+		if err := fn(num, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendVarints handles a repeated integer field, which the encoder may
+// write one value at a time (b == nil) or packed into one byte string.
+func appendVarints(dst []uint64, v uint64, b []byte) ([]uint64, error) {
+	if b == nil {
+		return append(dst, v), nil
+	}
+	for len(b) > 0 {
+		x, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, ErrProfileFormat
+		}
+		dst = append(dst, x)
+		b = b[n:]
+	}
+	return dst, nil
+}
+
+// Top sums the values of sampleType (matched on the type name, such as
+// "cpu" or "alloc_space") per function and returns the n largest by flat
+// value, together with the total and the unit.
+func (p *Profile) Top(sampleType string, n int) ([]ProfileEntry, int64, string, error) {
+	idx, unit := -1, ""
+	for i, t := range p.SampleTypes {
+		name, u, _ := strings.Cut(t, "/")
+		if name == sampleType {
+			idx, unit = i, u
+		}
+	}
+	if idx < 0 {
+		return nil, 0, "", fmt.Errorf("%w: tidak ada sampel %q (tersedia: %s)", ErrProfileFormat, sampleType, strings.Join(p.SampleTypes, ", "))
+	}
+
+	entries := map[string]*ProfileEntry{}
+	entry := func(name string) *ProfileEntry {
+		e, ok := entries[name]
+		if !ok {
+			e = &ProfileEntry{Func: name}
+			entries[name] = e
+		}
+		return e
+	}
+	var total int64
+	for _, s := range p.samples {
+		if idx >= len(s.values) || s.values[idx] == 0 {
+			continue
+		}
+		v := s.values[idx]
+		total += v
+		seen := map[string]bool{}
+		for i, loc := range s.locations {
+			for j, fn := range p.locations[loc] {
+				name := p.functions[fn]
+				if i == 0 && j == 0 {
+					entry(name).Flat += v
+				}
+				if !seen[name] {
+					seen[name] = true
+					entry(name).Cum += v
+				}
+			}
+		}
+	}
+
+	list := make([]ProfileEntry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, *e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Flat != list[j].Flat {
+			return list[i].Flat > list[j].Flat
+		}
+		if list[i].Cum != list[j].Cum {
+			return list[i].Cum > list[j].Cum
+		}
+		return list[i].Func < list[j].Func
+	})
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list, total, unit, nil
+}
+
+func (p *Profile) WriteTop(w io.Writer, title, sampleType string, n int) error {
+	list, total, unit, err := p.Top(sampleType, n)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Profil %s, total %s:\n", title, formatProfileValue(total, unit))
+	if total == 0 {
+		fmt.Fprintln(w, "  (tidak ada sampel; coba -repeat agar pelajaran berjalan lebih lama)")
+		return nil
+	}
+	fmt.Fprintf(w, "  %10s %6s %10s %6s  %s\n", "flat", "flat%", "cum", "cum%", "fungsi")
+	for _, e := range list {
+		fmt.Fprintf(w, "  %10s %5.1f%% %10s %5.1f%%  %s\n",
+			formatProfileValue(e.Flat, unit), percentOf(e.Flat, total),
+			formatProfileValue(e.Cum, unit), percentOf(e.Cum, total), e.Func)
+	}
+	return nil
+}
+
+func formatProfileValue(v int64, unit string) string {
+	switch unit {
+	case "nanoseconds":
+		d := time.Duration(v)
+		if d >= 10*time.Millisecond {
+			return d.Round(time.Millisecond).String()
+		}
+		return d.Round(time.Microsecond).String()
+	case "bytes":
+		return ByteSize(v).String()
+	}
+	return fmt.Sprint(v)
+}
+
+func percentOf(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
+
+// registerPprofHandlers mounts the net/http/pprof endpoints on mux under
+// /debug/pprof/, for servers that do not use http.DefaultServeMux.
+func registerPprofHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("/debug/pprof/", httppprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", httppprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", httppprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", httppprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", httppprof.Trace)
+}
+
+// startPprofServer listens on addr before returning, so a busy port is
+// reported before any lesson runs.
+func startPprofServer(addr string) (srv *http.Server, url string, serveErr <-chan error, err error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("gagal membuka %s: %w", addr, err)
+	}
+	mux := http.NewServeMux()
+	registerPprofHandlers(mux)
+	srv = &http.Server{Handler: mux}
+	return srv, "http://" + ln.Addr().String() + "/debug/pprof/", serveInBackground(srv, ln), nil
+}
+
+// serveInBackground runs srv.Serve(ln) in a goroutine. The channel
+// receives the error if Serve stops for any reason other than Shutdown.
+func serveInBackground(srv *http.Server, ln net.Listener) <-chan error {
+	errc := make(chan error, 1)
+	go func() {
+		if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+			errc <- err
+		}
+	}()
+	return errc
+}
+
+// waitForInterrupt blocks until Ctrl+C or SIGTERM and then shuts srv
+// down, letting requests in flight finish for up to five seconds. If the
+// server fails first, it returns that error instead of waiting on.
+func waitForInterrupt(srv *http.Server, serveErr <-chan error) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("server berhenti: %w", err)
+	case <-ctx.Done():
+	}
+	shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return srv.Shutdown(shutdown)
+}
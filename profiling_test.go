@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"slices"
+	"testing"
+)
+
+var profileSink [][]byte
+
+// profileAllocations allocates 128 blocks of 64 KiB, 8 MiB in total.
+//
+//go:noinline
+func profileAllocations() {
+	for range 128 {
+		profileSink = append(profileSink, make([]byte, 64<<10))
+	}
+}
+
+func TestReadHeapProfile(t *testing.T) {
+	defer func(rate int) { runtime.MemProfileRate = rate }(runtime.MemProfileRate)
+	runtime.MemProfileRate = 1 // every allocation, so the sizes are exact
+	profileAllocations()
+	profileSink = nil
+	runtime.GC() // the heap profile only counts up to the last GC
+
+	path := filepath.Join(t.TempDir(), "heap.pprof")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := ReadProfile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"alloc_objects/count", "alloc_space/bytes", "inuse_objects/count", "inuse_space/bytes"}
+	if !slices.Equal(p.SampleTypes, want) {
+		t.Errorf("SampleTypes = %v, mau %v", p.SampleTypes, want)
+	}
+
+	// A test binary names package main after the module path.
+	const name = "belajar-golang.profileAllocations"
+	for _, tt := range []struct {
+		sampleType, unit string
+		min              int64
+	}{
+		{"alloc_space", "bytes", 128 * 64 << 10},
+		{"alloc_objects", "count", 128},
+	} {
+		list, total, unit, err := p.Top(tt.sampleType, 1<<20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if unit != tt.unit {
+			t.Errorf("%s: satuan %q, mau %q", tt.sampleType, unit, tt.unit)
+		}
+		i := slices.IndexFunc(list, func(e ProfileEntry) bool { return e.Func == name })
+		if i < 0 {
+			t.Errorf("%s: %s tidak ada di profil", tt.sampleType, name)
+			continue
+		}
+		if e := list[i]; e.Flat < tt.min || e.Cum < e.Flat || total < e.Cum {
+			t.Errorf("%s: %s flat %d, cum %d, total %d, mau flat ≥ %d ≤ cum ≤ total", tt.sampleType, name, e.Flat, e.Cum, total, tt.min)
+		}
+		if !slices.IsSortedFunc(list, func(a, b ProfileEntry) int { return int(b.Flat - a.Flat) }) {
+			t.Errorf("%s: Top tidak urut menurut flat", tt.sampleType)
+		}
+	}
+
+	if list, _, _, _ := p.Top("alloc_space", 3); len(list) != 3 {
+		t.Errorf("Top(alloc_space, 3) memberi %d baris", len(list))
+	}
+	if _, _, _, err := p.Top("cpu", 3); !errors.Is(err, ErrProfileFormat) {
+		t.Errorf("Top(cpu) pada profil heap = %v, mau ErrProfileFormat", err)
+	}
+}
+
+func TestProtoFields(t *testing.T) {
+	type field struct {
+		num int
+		v   uint64
+		b   string
+	}
+	data := []byte{
+		0x08, 0x96, 0x01, // 1: varint 150
+		0x12, 0x03, 'a', 'b', 'c', // 2: "abc"
+		0x19, 1, 0, 0, 0, 0, 0, 0, 0, // 3: fixed64 1
+		0x25, 2, 0, 0, 0, // 4: fixed32 2
+		0x2a, 0x00, // 5: empty bytes
+	}
+	var got []field
+	err := protoFields(data, func(num int, v uint64, b []byte) error {
+		got = append(got, field{num, v, string(b)})
+		return nil
+	})
+	want := []field{{1, 150, ""}, {2, 0, "abc"}, {3, 1, ""}, {4, 2, ""}, {5, 0, ""}}
+	if err != nil || !slices.Equal(got, want) {
+		t.Errorf("protoFields = %v, %v, mau %v", got, err, want)
+	}
+
+	for _, bad := range [][]byte{
+		{0x08},                // varint hilang
+		{0x08, 0x80},          // varint terpotong
+		{0x12, 0x05, 'a'},     // panjang melebihi data
+		{0x19, 1, 2, 3},       // fixed64 terpotong
+		{0x25, 1},             // fixed32 terpotong
+		{0x0b},                // wire type 3 (group) tidak didukung
+		{0x80, 0x80, 0x80},    // kunci terpotong
+		{0x12, 0xff, 0xff, 1}, // panjang sangat besar
+	} {
+		err := protoFields(bad, func(int, uint64, []byte) error { return nil })
+		if !errors.Is(err, ErrProfileFormat) {
+			t.Errorf("protoFields(% x) = %v, mau ErrProfileFormat", bad, err)
+		}
+	}
+
+	packed, err := appendVarints(nil, 0, []byte{0x01, 0x96, 0x01, 0x00})
+	if err != nil || !slices.Equal(packed, []uint64{1, 150, 0}) {
+		t.Errorf("appendVarints packed = %v, %v, mau [1 150 0]", packed, err)
+	}
+	if _, err := ParseProfile([]byte{0x12, 0x02, 0x08}); !errors.Is(err, ErrProfileFormat) {
+		t.Errorf("ParseProfile dengan sampel terpotong = %v, mau ErrProfileFormat", err)
+	}
+}
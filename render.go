@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+// Renderable is implemented by shapes that know how to draw themselves as
+// an SVG element in their own local coordinates. Shapes that only
+// implement Bounded are drawn as their dashed bounding box instead.
+type Renderable interface {
+	SVGElement(style string) string
+}
+
+type Placed struct {
+	Shape Shape
+	At    Point
+}
+
+type Canvas struct {
+	Items   []Placed
+	Padding float64
+	Scale   float64
+}
+
+var svgPalette = []string{"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f", "#edc948", "#b07aa1", "#ff9da7"}
+
+const asciiPalette = "#*o@+%=x&$"
+
+func svgPoints(points []Point) string {
+	parts := make([]string, len(points))
+	for i, p := range points {
+		parts[i] = fmt.Sprintf("%.3f,%.3f", p.X, p.Y)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (r Rectangle) SVGElement(style string) string {
+	return fmt.Sprintf(`<rect x="0" y="0" width="%.3f" height="%.3f" %s/>`, r.Width, r.Height, style)
+}
+
+func (s Square) SVGElement(style string) string {
+	return s.Rectangle().SVGElement(style)
+}
+
+func (c Circle) SVGElement(style string) string {
+	return fmt.Sprintf(`<circle cx="0" cy="0" r="%.3f" %s/>`, c.Radius, style)
+}
+
+func (e Ellipse) SVGElement(style string) string {
+	return fmt.Sprintf(`<ellipse cx="%.3f" cy="%.3f" rx="%.3f" ry="%.3f" transform="rotate(%.3f %.3f %.3f)" %s/>`,
+		e.Center.X, e.Center.Y, e.RadiusX, e.RadiusY, e.Rotation*180/math.Pi, e.Center.X, e.Center.Y, style)
+}
+
+func (p Polygon) SVGElement(style string) string {
+	return fmt.Sprintf(`<polygon points="%s" %s/>`, svgPoints(p.Vertices), style)
+}
+
+func (t Triangle) SVGElement(style string) string {
+	return t.Polygon().SVGElement(style)
+}
+
+func (rp RegularPolygon) SVGElement(style string) string {
+	return rp.Polygon().SVGElement(style)
+}
+
+func shapeBounds(s Shape) (Bounds, bool) {
+	if b, ok := s.(Bounded); ok {
+		return b.BoundingBox(), true
+	}
+	return Bounds{}, false
+}
+
+func translateBounds(b Bounds, at Point) Bounds {
+	return Bounds{
+		Min: Point{b.Min.X + at.X, b.Min.Y + at.Y},
+		Max: Point{b.Max.X + at.X, b.Max.Y + at.Y},
+	}
+}
+
+// LayoutRow places the shapes left to right, bottom-aligned, leaving gap
+// units between neighbouring bounding boxes. A shape without a bounding
+// box cannot be placed and is an error.
+func LayoutRow(shapes []Shape, gap float64) (Canvas, error) {
+	canvas := Canvas{Padding: gap, Scale: 20}
+	x := 0.0
+	for i, s := range shapes {
+		b, ok := shapeBounds(s)
+		if !ok {
+			return Canvas{}, fmt.Errorf("shape #%d (%T) tidak punya bounding box", i+1, s)
+		}
+		at := Point{X: x - b.Min.X}
+		at.Y -= b.Min.Y
+		canvas.Items = append(canvas.Items, Placed{Shape: s, At: at})
+		x += b.Width() + gap
+	}
+	return canvas, nil
+}
+
+func (c Canvas) Bounds() Bounds {
+	var total Bounds
+	first := true
+	for _, item := range c.Items {
+		b, ok := shapeBounds(item.Shape)
+		if !ok {
+			continue
+		}
+		b = translateBounds(b, item.At)
+		if first {
+			total, first = b, false
+			continue
+		}
+		total = total.Union(b)
+	}
+	return total
+}
+
+func (c Canvas) WriteSVG(w io.Writer) error {
+	scale := c.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+	b := c.Bounds()
+	width := (b.Width() + 2*c.Padding) * scale
+	height := (b.Height() + 2*c.Padding) * scale
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.3f %.3f">`+"\n",
+		width, height, width, height)
+	// SVG grows downwards, our shapes grow upwards: flip the Y axis once
+	// for the whole drawing.
+	fmt.Fprintf(&sb, `  <g transform="scale(%.3f) translate(%.3f %.3f) scale(1 -1)">`+"\n",
+		scale, c.Padding-b.Min.X, c.Padding+b.Max.Y)
+
+	for i, item := range c.Items {
+		color := svgPalette[i%len(svgPalette)]
+		fmt.Fprintf(&sb, `    <g transform="translate(%.3f %.3f)">`, item.At.X, item.At.Y)
+		if r, ok := item.Shape.(Renderable); ok {
+			style := fmt.Sprintf(`fill="%s" fill-opacity="0.6" stroke="%s" stroke-width="%.3f"`, color, color, 1/scale)
+			sb.WriteString(r.SVGElement(style))
+		} else if bb, ok := shapeBounds(item.Shape); ok {
+			fmt.Fprintf(&sb, `<rect x="%.3f" y="%.3f" width="%.3f" height="%.3f" fill="none" stroke="%s" stroke-width="%.3f" stroke-dasharray="%.3f"/>`,
+				bb.Min.X, bb.Min.Y, bb.Width(), bb.Height(), color, 1/scale, 4/scale)
+		}
+		sb.WriteString("</g>\n")
+	}
+
+	sb.WriteString("  </g>\n</svg>\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func (c Canvas) SaveSVG(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("gagal membuat file SVG: %w", err)
+	}
+	if err := c.WriteSVG(f); err != nil {
+		f.Close()
+		return fmt.Errorf("gagal menulis SVG: %w", err)
+	}
+	return f.Close()
+}
+
+func (c Canvas) itemAt(p Point) int {
+	for i := len(c.Items) - 1; i >= 0; i-- {
+		item := c.Items[i]
+		local := Point{p.X - item.At.X, p.Y - item.At.Y}
+		if ct, ok := item.Shape.(Container); ok {
+			if ct.Contains(local) {
+				return i
+			}
+			continue
+		}
+		if b, ok := shapeBounds(item.Shape); ok && b.Contains(local) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ASCII rasterizes the canvas into cols characters per line. Terminal
+// cells are roughly twice as tall as they are wide, so every row covers
+// two horizontal cells' worth of height.
+func (c Canvas) ASCII(cols int) string {
+	b := c.Bounds()
+	if cols <= 0 || b.Width() <= 0 || b.Height() <= 0 {
+		return ""
+	}
+	cell := b.Width() / float64(cols)
+	rows := int(math.Ceil(b.Height() / (2 * cell)))
+
+	var sb strings.Builder
+	for row := range rows {
+		y := b.Max.Y - (float64(row)+0.5)*2*cell
+		for col := range cols {
+			x := b.Min.X + (float64(col)+0.5)*cell
+			if i := c.itemAt(Point{x, y}); i >= 0 {
+				sb.WriteByte(asciiPalette[i%len(asciiPalette)])
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func renderExample() {
+	shapes := []Shape{
+		Rectangle{Width: 4, Height: 3},
+		Circle{Radius: 2},
+		Triangle{A: Point{0, 0}, B: Point{4, 0}, C: Point{2, 4}},
+		Ellipse{RadiusX: 3, RadiusY: 1.5, Rotation: math.Pi / 6},
+		RegularPolygon{Sides: 6, Radius: 2},
+	}
+
+	canvas, err := LayoutRow(shapes, 1)
+	if err != nil {
+		logln("Gagal menata shape:", err)
+		return
+	}
+	logln("Hasil rasterisasi ASCII:")
+	logf("%s", canvas.ASCII(72))
+
+	// The lesson keeps the SVG in memory; `go run . shapes -svg out.svg
+	// data/shapes.json` writes one to disk.
+	var svg strings.Builder
+	if err := canvas.WriteSVG(&svg); err != nil {
+		logln("Gagal membuat SVG:", err)
+		return
+	}
+	first, _, _ := strings.Cut(svg.String(), "\n")
+	logf("SVG: %d byte, %d elemen shape\n", svg.Len(), len(canvas.Items))
+	logln(first)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// renderShapes is the row renderExample draws.
+var renderShapes = []Shape{
+	Rectangle{Width: 4, Height: 3},
+	Circle{Radius: 2},
+	Triangle{A: Point{0, 0}, B: Point{4, 0}, C: Point{2, 4}},
+	Ellipse{RadiusX: 3, RadiusY: 1.5, Rotation: math.Pi / 6},
+	RegularPolygon{Sides: 6, Radius: 2},
+}
+
+func TestRenderGolden(t *testing.T) {
+	canvas, err := LayoutRow(renderShapes, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var svg strings.Builder
+	if err := canvas.WriteSVG(&svg); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "render_row.svg", svg.String())
+	checkGolden(t, "render_row.txt", canvas.ASCII(72))
+}
+
+// unbounded is a Shape without a BoundingBox.
+type unbounded struct{}
+
+func (unbounded) Area() float64      { return 1 }
+func (unbounded) Perimeter() float64 { return 4 }
+
+func TestLayoutRow(t *testing.T) {
+	canvas, err := LayoutRow([]Shape{Square{Side: 2}, Circle{Radius: 1}}, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Bottom-aligned, left to right, gap apart.
+	want := []Point{{0, 0}, {3.5, 1}}
+	for i, item := range canvas.Items {
+		if item.At != want[i] {
+			t.Errorf("item %d di %v, mau %v", i, item.At, want[i])
+		}
+	}
+	if b := canvas.Bounds(); b != (Bounds{Min: Point{0, 0}, Max: Point{4.5, 2}}) {
+		t.Errorf("Bounds = %v", b)
+	}
+
+	if _, err := LayoutRow([]Shape{Square{Side: 1}, unbounded{}}, 1); err == nil || !strings.Contains(err.Error(), "#2") {
+		t.Errorf("shape tanpa bounding box: err = %v, mau error untuk shape #2", err)
+	}
+	if got := (Canvas{}).ASCII(10); got != "" {
+		t.Errorf("ASCII kanvas kosong = %q", got)
+	}
+}
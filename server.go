@@ -0,0 +1,679 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"belajar-golang/internal/addressbook"
+)
+
+// APIServer exposes the address book, the employee directory, the shape
+// codec and divide over HTTP as JSON. Routing uses the method patterns of
+// Go 1.22 ("GET /people/{id}"), so a wrong method is answered with 405 by
+// the mux itself.
+//
+// Middleware, from the outside in: request logging, a per-request timeout
+// and panic recovery. Recovery sits inside the timeout so that it runs on
+// the goroutine that panicked and can log the original stack.
+type APIServer struct {
+	book    *addressbook.AddressBook
+	logger  *slog.Logger
+	timeout time.Duration
+
+	// Directory has no locking of its own, unlike AddressBook.
+	mu  sync.RWMutex
+	dir *Directory
+}
+
+var (
+	errBadRequest = errors.New("permintaan tidak valid")
+	errTooLarge   = errors.New("body permintaan terlalu besar")
+)
+
+// employeeJSON is the wire form of an employee: the Employee fields plus
+// the OrgMember data, named like the columns of employees.csv.
+type employeeJSON struct {
+	ID         int     `json:"id"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	Position   string  `json:"position"`
+	Salary     float64 `json:"salary"`
+	Active     bool    `json:"active"`
+	Department string  `json:"department"`
+	ManagerID  int     `json:"manager_id"`
+}
+
+type shapeAreaJSON struct {
+	Type      string  `json:"type"`
+	Area      float64 `json:"area"`
+	Perimeter float64 `json:"perimeter"`
+}
+
+type calcRequest struct {
+	Op string `json:"op"`
+	A  int    `json:"a"`
+	B  int    `json:"b"`
+}
+
+func init() {
+	registerCommand(command{
+		Name:  "serve",
+		Usage: "serve [-addr HOST:PORT] [-book FILE] [-employees FILE.csv] [-timeout D] [-pprof] [-log-format text|json]",
+		Run:   serveCommand,
+	})
+}
+
+func NewAPIServer(book *addressbook.AddressBook, dir *Directory, logger *slog.Logger) *APIServer {
+	return &APIServer{book: book, dir: dir, logger: logger, timeout: 5 * time.Second}
+}
+
+func (s *APIServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /people", s.listPeople)
+	mux.HandleFunc("POST /people", s.createPerson)
+	mux.HandleFunc("GET /people/{id}", s.getPerson)
+	mux.HandleFunc("PUT /people/{id}", s.updatePerson)
+	mux.HandleFunc("DELETE /people/{id}", s.deletePerson)
+	mux.HandleFunc("GET /employees", s.listEmployees)
+	mux.HandleFunc("POST /employees", s.createEmployee)
+	mux.HandleFunc("GET /employees/{id}", s.getEmployee)
+	mux.HandleFunc("PUT /employees/{id}", s.updateEmployee)
+	mux.HandleFunc("DELETE /employees/{id}", s.deleteEmployee)
+	mux.HandleFunc("GET /employees/{id}/reports", s.listReports)
+	mux.HandleFunc("POST /shapes/area", s.shapeArea)
+	mux.HandleFunc("POST /calc", s.calc)
+	return s.middleware(mux)
+}
+
+// middleware wraps h in the logging, timeout and recovery layers that
+// every route gets.
+func (s *APIServer) middleware(h http.Handler) http.Handler {
+	h = recoverPanics(s.logger, h)
+	h = http.TimeoutHandler(h, s.timeout, `{"error":"waktu permintaan habis"}`)
+	return logRequests(s.logger, h)
+}
+
+// statusRecorder remembers the status code for the request log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(p)
+	r.size += n
+	return n, err
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func logRequests(logger *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		logger.Info("permintaan",
+			"method", r.Method,
+			"path", r.URL.RequestURI(),
+			"status", rec.status,
+			"bytes", rec.size,
+			"duration", time.Since(start).Round(time.Microsecond))
+	})
+}
+
+// recoverPanics is mightPanic's defer/recover turned into middleware: a
+// handler that panics answers 500 instead of killing the connection.
+// http.ErrAbortHandler is the one panic net/http expects to see, so it is
+// passed on.
+func recoverPanics(logger *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+			logger.Error("panic", "method", r.Method, "path", r.URL.Path, "panic", v, "stack", string(debug.Stack()))
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "kesalahan internal server"})
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func statusFor(err error) int {
+	var dup *addressbook.DuplicateError
+	var cycle *CycleError
+	switch {
+	case errors.Is(err, errBadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, errTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, addressbook.ErrEntryNotFound), errors.Is(err, ErrEmployeeNotFound):
+		return http.StatusNotFound
+	case errors.As(err, &dup), errors.As(err, &cycle), errors.Is(err, ErrDuplicateEmployee):
+		return http.StatusConflict
+	case errors.Is(err, ErrDivisionByZero):
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+// writeError answers with the status that matches err. Internal errors
+// are logged but not shown to the client.
+func (s *APIServer) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status := statusFor(err)
+	msg := err.Error()
+	if status == http.StatusInternalServerError {
+		s.logger.Error("kesalahan internal", "method", r.Method, "path", r.URL.Path, errorAttr(err))
+		msg = "kesalahan internal server"
+	}
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// decodeJSON reads a single JSON value of at most 1 MiB (a larger body is
+// a 413) and rejects unknown fields, so a typo in a field name is an
+// error, not a zero value.
+// DisallowUnknownFields does not reach into types with their own
+// UnmarshalJSON; ShapeList gets the same check from UnmarshalShape.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return fmt.Errorf("%w: lebih dari %d byte", errTooLarge, tooLarge.Limit)
+		}
+		return fmt.Errorf("%w: %v", errBadRequest, err)
+	}
+	if dec.More() {
+		return fmt.Errorf("%w: data tambahan setelah JSON", errBadRequest)
+	}
+	return nil
+}
+
+func pathID(r *http.Request) (int, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return 0, fmt.Errorf("%w: id %q bukan angka", errBadRequest, r.PathValue("id"))
+	}
+	return id, nil
+}
+
+func (s *APIServer) listPeople(w http.ResponseWriter, r *http.Request) {
+	var entries []addressbook.Entry
+	switch q := r.URL.Query(); {
+	case q.Has("name"):
+		entries = s.book.SearchByName(q.Get("name"))
+	case q.Has("city"):
+		entries = s.book.SearchByCity(q.Get("city"))
+	default:
+		entries = s.book.List()
+	}
+	if entries == nil {
+		entries = []addressbook.Entry{}
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
+
+func (s *APIServer) createPerson(w http.ResponseWriter, r *http.Request) {
+	var in addressbook.Entry
+	if err := decodeJSON(w, r, &in); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	e, err := s.book.Add(in)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/people/%d", e.ID))
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (s *APIServer) getPerson(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	e, err := s.book.Get(id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *APIServer) updatePerson(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	var in addressbook.Entry
+	if err := decodeJSON(w, r, &in); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if err := s.book.Update(id, in); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	e, err := s.book.Get(id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *APIServer) deletePerson(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if err := s.book.Delete(id); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func employeeToJSON(m OrgMember) employeeJSON {
+	e := m.Employee
+	return employeeJSON{
+		ID:         e.ID,
+		FirstName:  e.FirstName,
+		LastName:   e.LastName,
+		Position:   e.Position,
+		Salary:     e.Salary,
+		Active:     e.IsActive,
+		Department: m.Department,
+		ManagerID:  m.ManagerID,
+	}
+}
+
+func (j employeeJSON) employee() Employee {
+	return Employee{
+		ID:        j.ID,
+		FirstName: j.FirstName,
+		LastName:  j.LastName,
+		Position:  j.Position,
+		Salary:    j.Salary,
+		IsActive:  j.Active,
+	}
+}
+
+// employees returns the wire form of the employees in ids. The caller
+// holds s.mu.
+func (s *APIServer) employees(ids []int) []employeeJSON {
+	list := make([]employeeJSON, 0, len(ids))
+	for _, id := range ids {
+		if m, err := s.dir.Get(id); err == nil {
+			list = append(list, employeeToJSON(m))
+		}
+	}
+	return list
+}
+
+func (s *APIServer) listEmployees(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids []int
+	for id := range s.dir.All() {
+		ids = append(ids, id)
+	}
+	writeJSON(w, http.StatusOK, s.employees(ids))
+}
+
+// listReports lists everyone under an employee, nearest level first.
+// ?limit=N stops the walk after N people.
+func (s *APIServer) listReports(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	limit := -1
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit < 0 {
+			s.writeError(w, r, fmt.Errorf("%w: limit %q", errBadRequest, v))
+			return
+		}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, err := s.dir.Get(id); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	reports := s.dir.Reports(id)
+	if limit >= 0 {
+		reports = SeqTake(reports, limit)
+	}
+	ids := slices.Collect(SeqMap(reports, func(e Employee) int { return e.ID }))
+	writeJSON(w, http.StatusOK, s.employees(ids))
+}
+
+func (s *APIServer) getEmployee(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, err := s.dir.Get(id)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, employeeToJSON(m))
+}
+
+// createEmployee takes the ID from the body, or the next free one when
+// the body leaves it out.
+func (s *APIServer) createEmployee(w http.ResponseWriter, r *http.Request) {
+	var in employeeJSON
+	if err := decodeJSON(w, r, &in); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if in.ID == 0 {
+		for id := range s.dir.All() {
+			in.ID = max(in.ID, id)
+		}
+		in.ID++
+	}
+	if in.ManagerID != 0 {
+		if _, err := s.dir.Get(in.ManagerID); err != nil {
+			s.writeError(w, r, fmt.Errorf("%w: manajer: %v", errBadRequest, err))
+			return
+		}
+	}
+	if err := s.dir.Add(in.employee(), in.Department, in.ManagerID); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/employees/%d", in.ID))
+	writeJSON(w, http.StatusCreated, in)
+}
+
+func (s *APIServer) updateEmployee(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	var in employeeJSON
+	if err := decodeJSON(w, r, &in); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	if in.ID != 0 && in.ID != id {
+		s.writeError(w, r, fmt.Errorf("%w: id di body (%d) berbeda dengan URL (%d)", errBadRequest, in.ID, id))
+		return
+	}
+	in.ID = id
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.dir.Update(in.employee(), in.Department, in.ManagerID); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, in)
+}
+
+func (s *APIServer) deleteEmployee(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.dir.Remove(id); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// shapeArea accepts the same type-tagged list as the shapes command,
+// e.g. [{"type":"circle","radius":7},{"type":"square","side":2}].
+func (s *APIServer) shapeArea(w http.ResponseWriter, r *http.Request) {
+	var shapes ShapeList
+	if err := decodeJSON(w, r, &shapes); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	out := struct {
+		Shapes    []shapeAreaJSON `json:"shapes"`
+		TotalArea float64         `json:"total_area"`
+	}{Shapes: []shapeAreaJSON{}}
+	for shape, area := range shapes.Areas() {
+		name, err := ShapeTypeName(shape)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		out.Shapes = append(out.Shapes, shapeAreaJSON{Type: name, Area: area, Perimeter: shape.Perimeter()})
+		out.TotalArea += area
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// calc does integer arithmetic the way the functions lesson does it;
+// division goes through divide, so dividing by zero is a 422 carrying
+// ErrDivisionByZero's message.
+func (s *APIServer) calc(w http.ResponseWriter, r *http.Request) {
+	var in calcRequest
+	if err := decodeJSON(w, r, &in); err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	var result int
+	switch in.Op {
+	case "+":
+		result = add(in.A, in.B)
+	case "-":
+		result = subtract(in.A, in.B)
+	case "*":
+		result = in.A * in.B
+	case "/":
+		var err error
+		if result, err = divide(in.A, in.B); err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+	default:
+		s.writeError(w, r, fmt.Errorf("%w: operator %q (pilih +, -, * atau /)", errBadRequest, in.Op))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]int{"result": result})
+}
+
+func serveCommand(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", "localhost:8080", "alamat yang didengarkan")
+	bookPath := fs.String("book", "", "file buku alamat (JSON lines); kosong = hanya di memori")
+	employeesPath := fs.String("employees", "", "CSV karyawan; kosong = data contoh")
+	timeout := fs.Duration("timeout", 5*time.Second, "batas waktu per permintaan")
+	pprof := fs.Bool("pprof", false, "sediakan juga /debug/pprof/")
+	logFormat := fs.String("log-format", "text", "format log ke stderr: text atau json")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *timeout <= 0 {
+		return errUsage
+	}
+
+	book := addressbook.NewAddressBook()
+	if *bookPath != "" {
+		var err error
+		if book, err = addressbook.OpenAddressBook(*bookPath); err != nil {
+			return err
+		}
+	}
+	var employees io.Reader = strings.NewReader(sampleEmployeesCSV)
+	if *employeesPath != "" {
+		f, err := os.Open(*employeesPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		employees = f
+	}
+	dir, err := LoadDirectoryCSV(employees)
+	if err != nil {
+		return err
+	}
+
+	var handler slog.Handler
+	switch *logFormat {
+	case "text":
+		handler = slog.NewTextHandler(os.Stderr, nil)
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, nil)
+	default:
+		return fmt.Errorf("%w: -log-format %q (pilih text atau json)", errUsage, *logFormat)
+	}
+	logger := slog.New(handler)
+	api := NewAPIServer(book, dir, logger)
+	api.timeout = *timeout
+	routes := api.Handler()
+	if *pprof {
+		// Outside the API's timeout: /debug/pprof/profile runs for 30s.
+		mux := http.NewServeMux()
+		registerPprofHandlers(mux)
+		mux.Handle("/", routes)
+		routes = mux
+	}
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return fmt.Errorf("gagal membuka %s: %w", *addr, err)
+	}
+	srv := &http.Server{
+		Handler:           routes,
+		ReadHeaderTimeout: 5 * time.Second,
+		IdleTimeout:       time.Minute,
+		ErrorLog:          slog.NewLogLogger(handler, slog.LevelError),
+	}
+	serveErr := serveInBackground(srv, ln)
+	fmt.Printf("API berjalan di http://%s (Ctrl+C atau SIGTERM untuk berhenti)\n", ln.Addr())
+	if err := waitForInterrupt(srv, serveErr); err != nil {
+		return err
+	}
+	fmt.Println("Server berhenti.")
+	return nil
+}
+
+// httpAPIExample starts the API on a random local port and talks to it
+// with an ordinary http.Client, exactly as any other client would.
+// server_test.go drives the same routes through net/http/httptest.
+func httpAPIExample() {
+	dir, err := LoadDirectoryCSV(strings.NewReader(sampleEmployeesCSV))
+	if err != nil {
+		logln("Error:", err)
+		return
+	}
+	api := NewAPIServer(addressbook.NewAddressBook(), dir, slog.New(slog.DiscardHandler))
+	mux := http.NewServeMux()
+	mux.Handle("/", api.Handler())
+	// The same middleware around a handler that panics.
+	mux.Handle("GET /panic", api.middleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("Sesuatu yang sangat buruk terjadi!")
+	})))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		logln("Error:", err)
+		return
+	}
+	srv := &http.Server{Handler: mux}
+	go srv.Serve(ln)
+	defer srv.Close()
+	base := "http://" + ln.Addr().String()
+
+	do := func(method, path, body string) {
+		req, err := http.NewRequest(method, base+path, strings.NewReader(body))
+		if err != nil {
+			logln("Error:", err)
+			return
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			logln("Error:", err)
+			return
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		logf("%-6s %-28s -> %d %s\n", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	alice := `{"person":{"FirstName":"Alice","LastName":"Smith","Age":30},"contact":{"Email":"alice@example.com"}}`
+	do("POST", "/people", alice)
+	do("POST", "/people", alice)
+	do("GET", "/people/1", "")
+	do("DELETE", "/people/1", "")
+	do("GET", "/people/1", "")
+	do("GET", "/people/abc", "")
+
+	do("GET", "/employees/2", "")
+	do("GET", "/employees/2/reports?limit=2", "")
+	do("POST", "/employees", `{"first_name":"Tono","position":"Intern","department":"Teknologi","manager_id":4}`)
+	do("PUT", "/employees/11", `{"first_name":"Tono","position":"Junior Engineer","department":"Teknologi","manager_id":4,"active":true}`)
+	do("PUT", "/employees/2", `{"first_name":"Budi","position":"CTO","manager_id":4}`)
+	do("DELETE", "/employees/4", "")
+	do("GET", "/employees/11", "")
+
+	do("POST", "/shapes/area", `[{"type":"circle","radius":7},{"type":"square","side":2}]`)
+	do("POST", "/shapes/area", `[{"type":"hexagon"}]`)
+	do("POST", "/shapes/area", `[{"type":"circle","radus":7}]`)
+	do("POST", "/calc", `{"op":"/","a":10,"b":3}`)
+	do("POST", "/calc", `{"op":"/","a":1,"b":0}`)
+	do("GET", "/calc", "")
+	do("GET", "/panic", "")
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"belajar-golang/internal/addressbook"
+)
+
+func newTestAPI(t *testing.T) (*APIServer, *RecordingHandler) {
+	t.Helper()
+	dir, err := LoadDirectoryCSV(strings.NewReader(sampleEmployeesCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := NewRecordingHandler()
+	return NewAPIServer(addressbook.NewAddressBook(), dir, slog.New(rec)), rec
+}
+
+type apiStep struct {
+	method, path, body string
+	status             int
+	contains           string // part of the response body
+}
+
+// runSteps sends the steps in order to one server, so later steps see the
+// changes of earlier ones.
+func runSteps(t *testing.T, url string, client *http.Client, steps []apiStep) {
+	t.Helper()
+	for _, st := range steps {
+		req, err := http.NewRequest(st.method, url+st.path, strings.NewReader(st.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", st.method, st.path, err)
+		}
+		data, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != st.status {
+			t.Errorf("%s %s: status %d, mau %d (%s)", st.method, st.path, resp.StatusCode, st.status, data)
+		}
+		if !strings.Contains(string(data), st.contains) {
+			t.Errorf("%s %s: body %s tidak memuat %q", st.method, st.path, data, st.contains)
+		}
+	}
+}
+
+func TestAPIPeople(t *testing.T) {
+	api, _ := newTestAPI(t)
+	srv := httptest.NewServer(api.Handler())
+	defer srv.Close()
+
+	alice := `{"person":{"FirstName":"Alice","LastName":"Smith","Age":30},"contact":{"Email":"alice@example.com","HomeAddress":{"City":"Bandung"}}}`
+	runSteps(t, srv.URL, srv.Client(), []apiStep{
+		{"GET", "/people", "", 200, "[]"},
+		{"POST", "/people", alice, 201, `"id":1`},
+		{"POST", "/people", alice, 409, "duplikat"},
+		{"POST", "/people", `{"person":{"FirstName":"Bob"},"contact":{"Emial":"bob@example.com"}}`, 400, `unknown field \"Emial\"`},
+		{"POST", "/people", `{"person":{}} {}`, 400, "data tambahan"},
+		{"POST", "/people", `{"person":{"FirstName":"` + strings.Repeat("x", 1<<20) + `"}}`, 413, "terlalu besar"},
+		{"GET", "/people/1", "", 200, `"FirstName":"Alice"`},
+		{"GET", "/people?city=bandung", "", 200, `"id":1`},
+		{"PUT", "/people/1", `{"person":{"FirstName":"Alice","LastName":"Jones","Age":31},"married":true,"contact":{"Email":"alice@example.com"}}`, 200, `"married":true`},
+		{"GET", "/people?name=jones", "", 200, `"LastName":"Jones"`},
+		{"PUT", "/people/9", alice, 404, "tidak ditemukan"},
+		{"DELETE", "/people/1", "", 204, ""},
+		{"GET", "/people/1", "", 404, "tidak ditemukan"},
+		{"DELETE", "/people/1", "", 404, "tidak ditemukan"},
+		{"GET", "/people/abc", "", 400, "bukan angka"},
+		{"PATCH", "/people/1", "", 405, ""},
+	})
+}
+
+func TestAPIEmployees(t *testing.T) {
+	api, _ := newTestAPI(t)
+	srv := httptest.NewServer(api.Handler())
+	defer srv.Close()
+
+	runSteps(t, srv.URL, srv.Client(), []apiStep{
+		{"GET", "/employees/2", "", 200, `"position":"CTO"`},
+		{"GET", "/employees/99", "", 404, ""},
+		{"GET", "/employees/2/reports?limit=2", "", 200, `"id":8`},
+		{"GET", "/employees/2/reports?limit=-1", "", 400, "limit"},
+		{"GET", "/employees/99/reports", "", 404, ""},
+		{"POST", "/employees", `{"first_name":"Tono","position":"Intern","department":"Teknologi","manager_id":4}`, 201, `"id":11`},
+		{"POST", "/employees", `{"id":11,"first_name":"Tini"}`, 409, ""},
+		{"POST", "/employees", `{"first_name":"Tini","manager_id":99}`, 400, "manajer"},
+		{"POST", "/employees", `{"first_name":"Tini","salery":1}`, 400, `unknown field \"salery\"`},
+		{"PUT", "/employees/11", `{"first_name":"Tono","position":"Junior Engineer","manager_id":4,"active":true}`, 200, "Junior Engineer"},
+		{"PUT", "/employees/11", `{"id":12,"first_name":"Tono"}`, 400, "berbeda"},
+		{"PUT", "/employees/2", `{"first_name":"Budi","position":"CTO","manager_id":4}`, 409, "siklus"},
+		{"DELETE", "/employees/4", "", 204, ""},
+		{"GET", "/employees/11", "", 200, `"manager_id":2`},
+		{"DELETE", "/employees/4", "", 404, ""},
+		{"POST", "/employees/4", "", 405, ""},
+	})
+}
+
+func TestAPIShapesAndCalc(t *testing.T) {
+	api, _ := newTestAPI(t)
+	srv := httptest.NewServer(api.Handler())
+	defer srv.Close()
+
+	runSteps(t, srv.URL, srv.Client(), []apiStep{
+		{"POST", "/shapes/area", `[{"type":"square","side":2},{"type":"rectangle","width":2,"height":3}]`, 200, `"total_area":10`},
+		{"POST", "/shapes/area", `[]`, 200, `"shapes":[]`},
+		{"POST", "/shapes/area", `[{"type":"hexagon"}]`, 400, "tidak dikenal"},
+		// ShapeList decodes each element with UnmarshalShape, which is as
+		// strict about unknown fields as decodeJSON.
+		{"POST", "/shapes/area", `[{"type":"circle","radus":7}]`, 400, `unknown field \"radus\"`},
+		{"POST", "/calc", `{"op":"+","a":2,"b":3}`, 200, `"result":5`},
+		{"POST", "/calc", `{"op":"/","a":10,"b":3}`, 200, `"result":3`},
+		{"POST", "/calc", `{"op":"/","a":1,"b":0}`, 422, "dibagi dengan nol"},
+		{"POST", "/calc", `{"op":"%","a":1,"b":2}`, 400, "operator"},
+		{"POST", "/calc", `{"op":"+","a":"satu"}`, 400, ""},
+		{"GET", "/calc", "", 405, ""},
+		{"GET", "/tidak-ada", "", 404, ""},
+	})
+}
+
+func TestAPIMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  func(s *APIServer, w http.ResponseWriter, r *http.Request)
+		status   int
+		contains string
+		logged   string // message of the error record, if any
+	}{
+		{"panic", func(*APIServer, http.ResponseWriter, *http.Request) {
+			panic("rusak")
+		}, 500, "kesalahan internal server", "panic"},
+		{"timeout", func(_ *APIServer, w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}, 503, "waktu permintaan habis", ""},
+		{"error internal", func(s *APIServer, w http.ResponseWriter, r *http.Request) {
+			s.writeError(w, r, io.ErrUnexpectedEOF)
+		}, 500, "kesalahan internal server", "kesalahan internal"},
+	}
+	for _, tt := range tests {
+		api, rec := newTestAPI(t)
+		api.timeout = 20 * time.Millisecond
+		w := httptest.NewRecorder()
+		h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { tt.handler(api, w, r) })
+		api.middleware(h).ServeHTTP(w, httptest.NewRequest("GET", "/uji", nil))
+
+		if w.Code != tt.status || !strings.Contains(w.Body.String(), tt.contains) {
+			t.Errorf("%s: %d %s, mau %d dengan %q", tt.name, w.Code, w.Body, tt.status, tt.contains)
+		}
+		entries := rec.Entries()
+		last := entries[len(entries)-1]
+		if last.Message != "permintaan" {
+			t.Errorf("%s: catatan terakhir %v, mau log permintaan", tt.name, last)
+		}
+		if tt.logged != "" && (len(entries) < 2 || entries[0].Message != tt.logged || entries[0].Level != slog.LevelError) {
+			t.Errorf("%s: catatan %v, mau error %q", tt.name, entries, tt.logged)
+		}
+	}
+}
+
+func TestWaitForInterruptReturnsServeError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln.Close() // Serve fails at once on a closed listener
+	srv := &http.Server{}
+	done := make(chan error, 1)
+	go func() { done <- waitForInterrupt(srv, serveInBackground(srv, ln)) }()
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "server berhenti") {
+			t.Errorf("waitForInterrupt = %v, mau error dari Serve", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("waitForInterrupt masih menunggu Ctrl+C setelah Serve gagal")
+	}
+}
@@ -0,0 +1,611 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Shapes are encoded as type-tagged objects, e.g. {"type":"circle","radius":7}.
+// Every concrete type registers the tag it is known by; decoding looks the
+// tag up and unmarshals the remaining fields into a fresh value of that type.
+
+var (
+	shapeTypesByName = map[string]reflect.Type{}
+	shapeNamesByType = map[reflect.Type]string{}
+)
+
+type UnknownShapeError struct {
+	Type string
+}
+
+func (e *UnknownShapeError) Error() string {
+	return fmt.Sprintf("tipe shape tidak dikenal: %q", e.Type)
+}
+
+var errMissingShapeType = errors.New(`field "type" tidak ada`)
+
+type ShapeList []Shape
+
+func init() {
+	RegisterShape("rectangle", Rectangle{})
+	RegisterShape("circle", Circle{})
+	RegisterShape("square", Square{})
+	RegisterShape("triangle", Triangle{})
+	RegisterShape("ellipse", Ellipse{})
+	RegisterShape("regular_polygon", RegularPolygon{})
+	RegisterShape("polygon", Polygon{})
+
+	registerCommand(command{
+		Name:  "shapes",
+		Usage: "shapes [-to json|yaml] [-svg OUT] FILE   (membaca file .json/.yaml berisi daftar shape)",
+		Run:   shapesCommand,
+	})
+}
+
+func RegisterShape(name string, prototype Shape) {
+	t := reflect.TypeOf(prototype)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if _, exists := shapeTypesByName[name]; exists {
+		panic("tipe shape terdaftar dua kali: " + name)
+	}
+	shapeTypesByName[name] = t
+	shapeNamesByType[t] = name
+}
+
+func ShapeTypeName(s Shape) (string, error) {
+	t := reflect.TypeOf(s)
+	if t == nil {
+		return "", errors.New("shape nil tidak bisa di-encode")
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	name, ok := shapeNamesByType[t]
+	if !ok {
+		return "", fmt.Errorf("tipe %s belum didaftarkan dengan RegisterShape", t)
+	}
+	return name, nil
+}
+
+func MarshalShape(s Shape) ([]byte, error) {
+	name, err := ShapeTypeName(s)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) < 2 || body[0] != '{' {
+		return nil, fmt.Errorf("shape %q harus di-encode sebagai objek JSON", name)
+	}
+
+	tag, _ := json.Marshal(name)
+	var buf bytes.Buffer
+	buf.WriteString(`{"type":`)
+	buf.Write(tag)
+	if len(body) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.Write(body[1:])
+	return buf.Bytes(), nil
+}
+
+// UnmarshalShape decodes one type-tagged shape. Fields the type does not
+// have are an error, so a typo such as "radus" is reported instead of
+// leaving the radius at zero.
+func UnmarshalShape(data []byte) (Shape, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var name string
+	if raw, ok := fields["type"]; ok {
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return nil, fmt.Errorf(`field "type": %w`, err)
+		}
+	}
+	if name == "" {
+		return nil, errMissingShapeType
+	}
+	t, ok := shapeTypesByName[name]
+	if !ok {
+		return nil, &UnknownShapeError{Type: name}
+	}
+
+	delete(fields, "type")
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	v := reflect.New(t)
+	if err := dec.Decode(v.Interface()); err != nil {
+		return nil, fmt.Errorf("shape %q: %w", name, err)
+	}
+	// Types registered as &T{} have their methods on *T only.
+	if s, ok := v.Elem().Interface().(Shape); ok {
+		return s, nil
+	}
+	return v.Interface().(Shape), nil
+}
+
+func (l ShapeList) MarshalJSON() ([]byte, error) {
+	items := make([]json.RawMessage, len(l))
+	for i, s := range l {
+		data, err := MarshalShape(s)
+		if err != nil {
+			return nil, fmt.Errorf("shape #%d: %w", i, err)
+		}
+		items[i] = data
+	}
+	return json.Marshal(items)
+}
+
+func (l *ShapeList) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	shapes := make(ShapeList, len(items))
+	for i, item := range items {
+		s, err := UnmarshalShape(item)
+		if err != nil {
+			return fmt.Errorf("shape #%d: %w", i, err)
+		}
+		shapes[i] = s
+	}
+	*l = shapes
+	return nil
+}
+
+// The YAML codec goes through the same data model as JSON: shapes are
+// converted to plain maps and slices, which are then written as a small
+// block-style YAML subset (mappings, sequences and scalars).
+
+func EncodeShapesYAML(shapes []Shape) ([]byte, error) {
+	data, err := json.Marshal(ShapeList(shapes))
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var tree interface{}
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	writeYAML(&sb, tree, 0)
+	return []byte(sb.String()), nil
+}
+
+func DecodeShapesYAML(data []byte) ([]Shape, error) {
+	tree, err := parseYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	if tree == nil {
+		return nil, nil
+	}
+	if _, ok := tree.([]interface{}); !ok {
+		return nil, errors.New("yaml: dokumen harus berupa daftar shape")
+	}
+	asJSON, err := json.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+	var shapes ShapeList
+	if err := json.Unmarshal(asJSON, &shapes); err != nil {
+		return nil, err
+	}
+	return shapes, nil
+}
+
+func yamlScalar(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(x)
+	case json.Number:
+		return x.String()
+	case string:
+		if x == "" || strings.ContainsAny(x, ":#-[]{},&*!|>'\"%@`\n") || x != strings.TrimSpace(x) {
+			return strconv.Quote(x)
+		}
+		if yamlNumber.MatchString(x) {
+			return strconv.Quote(x)
+		}
+		switch x {
+		case "true", "false", "null", "~":
+			return strconv.Quote(x)
+		}
+		return x
+	case []interface{}:
+		return "[]"
+	case map[string]interface{}:
+		return "{}"
+	}
+	return fmt.Sprint(v)
+}
+
+func isYAMLBlock(v interface{}) bool {
+	switch x := v.(type) {
+	case []interface{}:
+		return len(x) > 0
+	case map[string]interface{}:
+		return len(x) > 0
+	}
+	return false
+}
+
+func sortedYAMLKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i] == "type" || keys[j] == "type" {
+			return keys[i] == "type"
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func writeYAML(sb *strings.Builder, v interface{}, indent int) {
+	pad := strings.Repeat(" ", indent)
+	switch x := v.(type) {
+	case []interface{}:
+		for _, item := range x {
+			if m, ok := item.(map[string]interface{}); ok && len(m) > 0 {
+				// The first key shares the line with the dash.
+				var inner strings.Builder
+				writeYAML(&inner, m, indent+2)
+				sb.WriteString(pad + "- " + strings.TrimPrefix(inner.String(), pad+"  "))
+				continue
+			}
+			if isYAMLBlock(item) {
+				sb.WriteString(pad + "-\n")
+				writeYAML(sb, item, indent+2)
+				continue
+			}
+			sb.WriteString(pad + "- " + yamlScalar(item) + "\n")
+		}
+	case map[string]interface{}:
+		for _, k := range sortedYAMLKeys(x) {
+			if isYAMLBlock(x[k]) {
+				sb.WriteString(pad + k + ":\n")
+				writeYAML(sb, x[k], indent+2)
+				continue
+			}
+			sb.WriteString(pad + k + ": " + yamlScalar(x[k]) + "\n")
+		}
+	default:
+		sb.WriteString(pad + yamlScalar(x) + "\n")
+	}
+}
+
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+func parseYAML(data []byte) (interface{}, error) {
+	var lines []yamlLine
+	for i, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(raw, " \t\r")
+		trimmed := strings.TrimLeft(raw, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("yaml baris %d: tab tidak boleh dipakai untuk indentasi", i+1)
+		}
+		lines = append(lines, yamlLine{num: i + 1, indent: len(raw) - len(trimmed), text: trimmed})
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	p := &yamlParser{lines: lines}
+	v, err := p.block(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		return nil, fmt.Errorf("yaml baris %d: indentasi tidak sesuai", l.num)
+	}
+	return v, nil
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+func isYAMLDash(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	if isYAMLDash(p.lines[p.pos].text) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) sequence(indent int) (interface{}, error) {
+	items := []interface{}{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent != indent || !isYAMLDash(l.text) {
+			break
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(l.text, "-"))
+		if rest == "" {
+			p.pos++
+			if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+				v, err := p.block(p.lines[p.pos].indent)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			} else {
+				items = append(items, nil)
+			}
+			continue
+		}
+
+		// "- key: value" opens a mapping whose keys line up with "key".
+		inner := indent + len(l.text) - len(strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " "))
+		if _, _, isPair := splitYAMLPair(rest); isPair {
+			p.lines[p.pos] = yamlLine{num: l.num, indent: inner, text: rest}
+			v, err := p.mapping(inner)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+			continue
+		}
+
+		v, err := parseYAMLScalar(rest, l.num)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+		p.pos++
+	}
+	return items, nil
+}
+
+func splitYAMLPair(text string) (key, value string, ok bool) {
+	if strings.HasPrefix(text, `"`) || strings.HasPrefix(text, "'") {
+		return "", "", false
+	}
+	if strings.HasSuffix(text, ":") {
+		return strings.TrimSuffix(text, ":"), "", true
+	}
+	i := strings.Index(text, ": ")
+	if i < 0 {
+		return "", "", false
+	}
+	return text[:i], strings.TrimSpace(text[i+2:]), true
+}
+
+func (p *yamlParser) mapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("yaml baris %d: indentasi tidak sesuai", l.num)
+		}
+		if isYAMLDash(l.text) {
+			break
+		}
+		key, value, ok := splitYAMLPair(l.text)
+		if !ok {
+			return nil, fmt.Errorf("yaml baris %d: diharapkan pasangan 'kunci: nilai'", l.num)
+		}
+		if _, dup := m[key]; dup {
+			return nil, fmt.Errorf("yaml baris %d: kunci %q muncul dua kali", l.num, key)
+		}
+		p.pos++
+
+		if value != "" {
+			v, err := parseYAMLScalar(value, l.num)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			continue
+		}
+
+		if p.pos < len(p.lines) {
+			next := p.lines[p.pos]
+			if next.indent > indent || (next.indent == indent && isYAMLDash(next.text)) {
+				v, err := p.block(next.indent)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = v
+				continue
+			}
+		}
+		m[key] = nil
+	}
+	return m, nil
+}
+
+// yamlNumber matches the decimal literals the codec reads as numbers.
+// ParseFloat alone would also take "inf", "nan" and hex floats, and
+// json.Marshal rejects the first two.
+var yamlNumber = regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][-+]?[0-9]+)?$`)
+
+func parseYAMLScalar(text string, line int) (interface{}, error) {
+	if i := strings.Index(text, " #"); i >= 0 && !strings.HasPrefix(text, `"`) && !strings.HasPrefix(text, "'") {
+		text = strings.TrimSpace(text[:i])
+	}
+	switch text {
+	case "null", "~":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "[]":
+		return []interface{}{}, nil
+	case "{}":
+		return map[string]interface{}{}, nil
+	}
+	if strings.HasPrefix(text, `"`) {
+		s, err := strconv.Unquote(text)
+		if err != nil {
+			return nil, fmt.Errorf("yaml baris %d: string tidak valid %s", line, text)
+		}
+		return s, nil
+	}
+	if strings.HasPrefix(text, "'") && strings.HasSuffix(text, "'") && len(text) >= 2 {
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), nil
+	}
+	if yamlNumber.MatchString(text) {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("yaml baris %d: angka di luar jangkauan %s", line, text)
+		}
+		return f, nil
+	}
+	return text, nil
+}
+
+func LoadShapesFile(path string) ([]Shape, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		shapes, err := DecodeShapesYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return shapes, nil
+	default:
+		var shapes ShapeList
+		if err := json.Unmarshal(data, &shapes); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return shapes, nil
+	}
+}
+
+func shapesCommand(args []string) error {
+	fs := flag.NewFlagSet("shapes", flag.ContinueOnError)
+	to := fs.String("to", "", "cetak ulang daftar shape dalam format json atau yaml")
+	svgPath := fs.String("svg", "", "gambar shape berjajar ke file SVG ini")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errUsage
+	}
+
+	shapes, err := LoadShapesFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	switch *to {
+	case "":
+	case "json":
+		data, err := json.MarshalIndent(ShapeList(shapes), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	case "yaml":
+		data, err := EncodeShapesYAML(shapes)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+		return nil
+	default:
+		return fmt.Errorf("%w: format -to tidak dikenal %q", errUsage, *to)
+	}
+
+	if *svgPath != "" {
+		canvas, err := LayoutRow(shapes, 1)
+		if err != nil {
+			return err
+		}
+		if err := canvas.SaveSVG(*svgPath); err != nil {
+			return err
+		}
+	}
+
+	totalArea := 0.0
+	for _, s := range shapes {
+		PrintShapeInfo(s)
+		totalArea += s.Area()
+	}
+	logf("\nTotal Area semua bentuk: %.2f\n", totalArea)
+	return nil
+}
+
+func shapeCodecExample() {
+	shapes := []Shape{
+		Circle{Radius: 7},
+		Rectangle{Width: 10, Height: 5},
+		Triangle{A: Point{0, 0}, B: Point{4, 0}, C: Point{0, 3}},
+	}
+
+	data, err := json.Marshal(ShapeList(shapes))
+	if err != nil {
+		logln("Gagal encode JSON:", err)
+		return
+	}
+	logln("JSON:", string(data))
+
+	var decoded ShapeList
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		logln("Gagal decode JSON:", err)
+		return
+	}
+	for _, s := range decoded {
+		logf("  Hasil decode: %T, Area: %.2f\n", s, s.Area())
+	}
+
+	yamlData, err := EncodeShapesYAML(decoded)
+	if err != nil {
+		logln("Gagal encode YAML:", err)
+		return
+	}
+	logf("YAML:\n%s", yamlData)
+
+	_, err = UnmarshalShape([]byte(`{"type":"hexagon","side":2}`))
+	var unknown *UnknownShapeError
+	if errors.As(err, &unknown) {
+		logln("Error untuk tipe tidak dikenal:", err)
+	}
+}
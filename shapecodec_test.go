@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalShapeErrors(t *testing.T) {
+	tests := []struct {
+		name, input, want string
+	}{
+		{"typo field", `{"type":"circle","radus":7}`, `unknown field "radus"`},
+		{"tanpa type", `{"radius":7}`, errMissingShapeType.Error()},
+		{"type bukan string", `{"type":7}`, `field "type"`},
+		{"tipe tidak dikenal", `{"type":"hexagon"}`, `tidak dikenal`},
+		{"bukan objek", `[1,2]`, `cannot unmarshal`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := UnmarshalShape([]byte(tt.input))
+			if err == nil {
+				t.Fatalf("UnmarshalShape(%s) = %#v, mau error", tt.input, s)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q tidak memuat %q", err, tt.want)
+			}
+		})
+	}
+
+	var unknown *UnknownShapeError
+	_, err := UnmarshalShape([]byte(`{"type":"hexagon"}`))
+	if !errors.As(err, &unknown) || unknown.Type != "hexagon" {
+		t.Errorf("errors.As(*UnknownShapeError) gagal untuk %v", err)
+	}
+}
+
+func TestShapeListRoundTrip(t *testing.T) {
+	shapes := ShapeList{
+		Rectangle{Width: 10, Height: 5},
+		Circle{Radius: 7},
+		Square{Side: 4},
+		Triangle{A: Point{0, 0}, B: Point{4, 0}, C: Point{0, 3}},
+		Ellipse{Center: Point{1, 1}, RadiusX: 2, RadiusY: 1, Rotation: 0.5},
+		RegularPolygon{Sides: 6, Radius: 2},
+		Polygon{Vertices: []Point{{0, 0}, {2, 0}, {2, 2}}},
+	}
+	data, err := json.Marshal(shapes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ShapeList
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("decode %s: %v", data, err)
+	}
+	if len(decoded) != len(shapes) {
+		t.Fatalf("%d shape, mau %d", len(decoded), len(shapes))
+	}
+	for i := range shapes {
+		if math.Abs(decoded[i].Area()-shapes[i].Area()) > 1e-9 {
+			t.Errorf("#%d %T: luas %v, mau %v", i, decoded[i], decoded[i].Area(), shapes[i].Area())
+		}
+	}
+}
+
+// blob has its methods on the pointer, like a shape that caches state.
+type blob struct {
+	R float64 `json:"r"`
+}
+
+func (b *blob) Area() float64      { return b.R * b.R }
+func (b *blob) Perimeter() float64 { return 4 * b.R }
+
+func TestUnmarshalPointerShape(t *testing.T) {
+	if _, ok := shapeTypesByName["test_blob"]; !ok {
+		RegisterShape("test_blob", &blob{})
+	}
+	s, err := UnmarshalShape([]byte(`{"type":"test_blob","r":3}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, ok := s.(*blob)
+	if !ok || b.R != 3 {
+		t.Fatalf("hasil %#v, mau &blob{R: 3}", s)
+	}
+	data, err := MarshalShape(s)
+	if err != nil || string(data) != `{"type":"test_blob","r":3}` {
+		t.Errorf("MarshalShape = %s, %v", data, err)
+	}
+}
+
+func TestShapesYAMLRoundTrip(t *testing.T) {
+	shapes := []Shape{
+		Rectangle{Width: 10, Height: 5},
+		Circle{Radius: 0.5},
+		Triangle{A: Point{0, 0}, B: Point{4, 0}, C: Point{0, -3}},
+		Ellipse{Center: Point{1, 1}, RadiusX: 2, RadiusY: 1, Rotation: 0.5},
+		RegularPolygon{Sides: 6, Radius: 2e-3},
+		Polygon{Vertices: []Point{{0, 0}, {2, 0}, {2, 2}}},
+	}
+	data, err := EncodeShapesYAML(shapes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeShapesYAML(data)
+	if err != nil {
+		t.Fatalf("decode:\n%s\n%v", data, err)
+	}
+	if len(decoded) != len(shapes) {
+		t.Fatalf("%d shape, mau %d", len(decoded), len(shapes))
+	}
+	for i := range shapes {
+		if math.Abs(decoded[i].Area()-shapes[i].Area()) > 1e-12 {
+			t.Errorf("#%d %T: luas %v, mau %v", i, decoded[i], decoded[i].Area(), shapes[i].Area())
+		}
+	}
+
+	file, err := LoadShapesFile("data/shapes.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromJSON, err := LoadShapesFile("data/shapes.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file) != len(fromJSON) {
+		t.Errorf("shapes.yaml berisi %d shape, shapes.json %d", len(file), len(fromJSON))
+	}
+}
+
+func TestDecodeShapesYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name, input, want string
+	}{
+		{"tab", "- type: circle\n\tradius: 1\n", "tab"},
+		{"indentasi", "- type: circle\n    radius: 1\n", "indentasi"},
+		{"kunci ganda", "- type: circle\n  radius: 1\n  radius: 2\n", `kunci "radius" muncul dua kali`},
+		{"bukan pasangan", "- type: circle\n  radius\n", "kunci: nilai"},
+		{"bukan daftar", "type: circle\nradius: 1\n", "daftar shape"},
+		{"string rusak", "- type: \"circle\n", "string tidak valid"},
+		{"typo field", "- type: circle\n  radus: 1\n", `unknown field "radus"`},
+		{"inf bukan angka", "- type: circle\n  radius: inf\n", "cannot unmarshal string"},
+		{"nan bukan angka", "- type: circle\n  radius: .nan\n", "cannot unmarshal string"},
+		{"di luar jangkauan", "- type: circle\n  radius: 1e999\n", "di luar jangkauan"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shapes, err := DecodeShapesYAML([]byte(tt.input))
+			if err == nil {
+				t.Fatalf("hasil %#v, mau error", shapes)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q tidak memuat %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseYAMLScalar(t *testing.T) {
+	tests := []struct {
+		in   string
+		want interface{}
+	}{
+		{"null", nil},
+		{"~", nil},
+		{"true", true},
+		{"12", 12.0},
+		{"-1.5e3", -1500.0},
+		{".5", 0.5},
+		{"+3.", 3.0},
+		{"7 # komentar", 7.0},
+		{"inf", "inf"},
+		{"NaN", "NaN"},
+		{"0x10", "0x10"},
+		{"1_000", "1_000"},
+		{`"12"`, "12"},
+		{`"a # b"`, "a # b"},
+		{"'it''s'", "it's"},
+		{"circle", "circle"},
+	}
+	for _, tt := range tests {
+		got, err := parseYAMLScalar(tt.in, 1)
+		if err != nil || got != tt.want {
+			t.Errorf("parseYAMLScalar(%q) = %#v, %v; mau %#v", tt.in, got, err, tt.want)
+		}
+	}
+	// A string that looks like a number is quoted on the way out.
+	for _, s := range []string{"12", "1e3", ".5"} {
+		if got := yamlScalar(s); got != strconv.Quote(s) {
+			t.Errorf("yamlScalar(%q) = %s, mau dikutip", s, got)
+		}
+	}
+	if got := yamlScalar("inf"); got != "inf" {
+		t.Errorf("yamlScalar(\"inf\") = %s", got)
+	}
+}
@@ -0,0 +1,439 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// Shapes live in their own local coordinates, following the SVG
+// convention: Rectangle and Square start at the origin and grow towards
+// +X/+Y, while Circle, Ellipse and RegularPolygon are centred on it.
+
+type Bounds struct {
+	Min, Max Point
+}
+
+type Matrix struct {
+	A, B, C, D, E, F float64
+}
+
+type Bounded interface {
+	BoundingBox() Bounds
+}
+
+type Container interface {
+	Contains(p Point) bool
+}
+
+type Centered interface {
+	Centroid() Point
+}
+
+type Transformer interface {
+	Transform(m Matrix) Shape
+}
+
+type SolidShape interface {
+	Shape
+	Bounded
+	Container
+	Centered
+}
+
+type Triangle struct {
+	A Point `json:"a"`
+	B Point `json:"b"`
+	C Point `json:"c"`
+}
+
+type Ellipse struct {
+	Center   Point   `json:"center"`
+	RadiusX  float64 `json:"rx"`
+	RadiusY  float64 `json:"ry"`
+	Rotation float64 `json:"rotation,omitempty"`
+}
+
+type RegularPolygon struct {
+	Sides  int     `json:"sides"`
+	Radius float64 `json:"radius"`
+}
+
+type Polygon struct {
+	Vertices []Point `json:"vertices"`
+}
+
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+func (b Bounds) Width() float64 {
+	return b.Max.X - b.Min.X
+}
+
+func (b Bounds) Height() float64 {
+	return b.Max.Y - b.Min.Y
+}
+
+func (b Bounds) Center() Point {
+	return Point{(b.Min.X + b.Max.X) / 2, (b.Min.Y + b.Max.Y) / 2}
+}
+
+func (b Bounds) Union(o Bounds) Bounds {
+	return Bounds{
+		Min: Point{math.Min(b.Min.X, o.Min.X), math.Min(b.Min.Y, o.Min.Y)},
+		Max: Point{math.Max(b.Max.X, o.Max.X), math.Max(b.Max.Y, o.Max.Y)},
+	}
+}
+
+func (b Bounds) Contains(p Point) bool {
+	return p.X >= b.Min.X && p.X <= b.Max.X && p.Y >= b.Min.Y && p.Y <= b.Max.Y
+}
+
+func (b Bounds) String() string {
+	return fmt.Sprintf("[(%.2f, %.2f) - (%.2f, %.2f)]", b.Min.X, b.Min.Y, b.Max.X, b.Max.Y)
+}
+
+func boundsOf(points []Point) Bounds {
+	if len(points) == 0 {
+		return Bounds{}
+	}
+	b := Bounds{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		b = b.Union(Bounds{Min: p, Max: p})
+	}
+	return b
+}
+
+func IdentityMatrix() Matrix {
+	return Matrix{A: 1, D: 1}
+}
+
+func TranslateMatrix(dx, dy float64) Matrix {
+	return Matrix{A: 1, D: 1, E: dx, F: dy}
+}
+
+func ScaleMatrix(sx, sy float64) Matrix {
+	return Matrix{A: sx, D: sy}
+}
+
+func RotateMatrix(radians float64) Matrix {
+	sin, cos := math.Sincos(radians)
+	return Matrix{A: cos, B: sin, C: -sin, D: cos}
+}
+
+// Multiply returns the matrix that applies n first and then m.
+func (m Matrix) Multiply(n Matrix) Matrix {
+	return Matrix{
+		A: m.A*n.A + m.C*n.B,
+		B: m.B*n.A + m.D*n.B,
+		C: m.A*n.C + m.C*n.D,
+		D: m.B*n.C + m.D*n.D,
+		E: m.A*n.E + m.C*n.F + m.E,
+		F: m.B*n.E + m.D*n.F + m.F,
+	}
+}
+
+func (m Matrix) Apply(p Point) Point {
+	return Point{m.A*p.X + m.C*p.Y + m.E, m.B*p.X + m.D*p.Y + m.F}
+}
+
+func (m Matrix) Determinant() float64 {
+	return m.A*m.D - m.B*m.C
+}
+
+func (m Matrix) applyAll(points []Point) []Point {
+	out := make([]Point, len(points))
+	for i, p := range points {
+		out[i] = m.Apply(p)
+	}
+	return out
+}
+
+func (r Rectangle) vertices() []Point {
+	return []Point{{0, 0}, {r.Width, 0}, {r.Width, r.Height}, {0, r.Height}}
+}
+
+func (r Rectangle) BoundingBox() Bounds {
+	return Bounds{Max: Point{r.Width, r.Height}}
+}
+
+func (r Rectangle) Contains(p Point) bool {
+	return r.BoundingBox().Contains(p)
+}
+
+func (r Rectangle) Centroid() Point {
+	return Point{r.Width / 2, r.Height / 2}
+}
+
+func (r Rectangle) Transform(m Matrix) Shape {
+	return Polygon{Vertices: m.applyAll(r.vertices())}
+}
+
+func (c Circle) BoundingBox() Bounds {
+	return Bounds{Min: Point{-c.Radius, -c.Radius}, Max: Point{c.Radius, c.Radius}}
+}
+
+func (c Circle) Contains(p Point) bool {
+	return p.DistanceFromOrigin() <= c.Radius
+}
+
+func (c Circle) Centroid() Point {
+	return Point{}
+}
+
+func (c Circle) Transform(m Matrix) Shape {
+	return Ellipse{RadiusX: c.Radius, RadiusY: c.Radius}.Transform(m)
+}
+
+func (s Square) Rectangle() Rectangle {
+	return Rectangle{Width: s.Side, Height: s.Side}
+}
+
+func (s Square) Area() float64 {
+	return s.Rectangle().Area()
+}
+
+func (s Square) Perimeter() float64 {
+	return s.Rectangle().Perimeter()
+}
+
+func (s Square) BoundingBox() Bounds {
+	return s.Rectangle().BoundingBox()
+}
+
+func (s Square) Contains(p Point) bool {
+	return s.Rectangle().Contains(p)
+}
+
+func (s Square) Centroid() Point {
+	return s.Rectangle().Centroid()
+}
+
+func (s Square) Transform(m Matrix) Shape {
+	return s.Rectangle().Transform(m)
+}
+
+func (t Triangle) Polygon() Polygon {
+	return Polygon{Vertices: []Point{t.A, t.B, t.C}}
+}
+
+func (t Triangle) Area() float64 {
+	return t.Polygon().Area()
+}
+
+func (t Triangle) Perimeter() float64 {
+	return t.Polygon().Perimeter()
+}
+
+func (t Triangle) BoundingBox() Bounds {
+	return t.Polygon().BoundingBox()
+}
+
+func (t Triangle) Contains(p Point) bool {
+	return t.Polygon().Contains(p)
+}
+
+func (t Triangle) Centroid() Point {
+	return Point{(t.A.X + t.B.X + t.C.X) / 3, (t.A.Y + t.B.Y + t.C.Y) / 3}
+}
+
+func (t Triangle) Transform(m Matrix) Shape {
+	return Triangle{A: m.Apply(t.A), B: m.Apply(t.B), C: m.Apply(t.C)}
+}
+
+func (p Polygon) signedArea() float64 {
+	sum := 0.0
+	n := len(p.Vertices)
+	for i := range n {
+		a, b := p.Vertices[i], p.Vertices[(i+1)%n]
+		sum += a.X*b.Y - b.X*a.Y
+	}
+	return sum / 2
+}
+
+func (p Polygon) Area() float64 {
+	return math.Abs(p.signedArea())
+}
+
+func (p Polygon) Perimeter() float64 {
+	total := 0.0
+	n := len(p.Vertices)
+	if n < 2 {
+		return 0
+	}
+	for i := range n {
+		a, b := p.Vertices[i], p.Vertices[(i+1)%n]
+		total += math.Hypot(b.X-a.X, b.Y-a.Y)
+	}
+	return total
+}
+
+func (p Polygon) BoundingBox() Bounds {
+	return boundsOf(p.Vertices)
+}
+
+// Contains uses the even-odd ray casting rule, so it also works for
+// concave polygons.
+func (p Polygon) Contains(pt Point) bool {
+	inside := false
+	n := len(p.Vertices)
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		a, b := p.Vertices[i], p.Vertices[j]
+		if (a.Y > pt.Y) != (b.Y > pt.Y) {
+			x := a.X + (pt.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if pt.X < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+func (p Polygon) Centroid() Point {
+	area := p.signedArea()
+	if area == 0 {
+		return boundsOf(p.Vertices).Center()
+	}
+	var cx, cy float64
+	n := len(p.Vertices)
+	for i := range n {
+		a, b := p.Vertices[i], p.Vertices[(i+1)%n]
+		cross := a.X*b.Y - b.X*a.Y
+		cx += (a.X + b.X) * cross
+		cy += (a.Y + b.Y) * cross
+	}
+	return Point{cx / (6 * area), cy / (6 * area)}
+}
+
+func (p Polygon) Transform(m Matrix) Shape {
+	return Polygon{Vertices: m.applyAll(p.Vertices)}
+}
+
+// Polygon lists the vertices, the first one straight up from the centre.
+// Fewer than three sides, including the negative counts a decoded file
+// may contain, give an empty polygon rather than a panic in make.
+func (rp RegularPolygon) Polygon() Polygon {
+	if rp.Sides < 3 {
+		return Polygon{}
+	}
+	vertices := make([]Point, rp.Sides)
+	for i := range rp.Sides {
+		angle := 2*math.Pi*float64(i)/float64(rp.Sides) - math.Pi/2
+		vertices[i] = Point{rp.Radius * math.Cos(angle), rp.Radius * math.Sin(angle)}
+	}
+	return Polygon{Vertices: vertices}
+}
+
+func (rp RegularPolygon) Area() float64 {
+	if rp.Sides < 3 {
+		return 0
+	}
+	n := float64(rp.Sides)
+	return n / 2 * rp.Radius * rp.Radius * math.Sin(2*math.Pi/n)
+}
+
+func (rp RegularPolygon) Perimeter() float64 {
+	if rp.Sides < 3 {
+		return 0
+	}
+	n := float64(rp.Sides)
+	return 2 * n * rp.Radius * math.Sin(math.Pi/n)
+}
+
+func (rp RegularPolygon) BoundingBox() Bounds {
+	return rp.Polygon().BoundingBox()
+}
+
+func (rp RegularPolygon) Contains(p Point) bool {
+	return rp.Polygon().Contains(p)
+}
+
+func (rp RegularPolygon) Centroid() Point {
+	return Point{}
+}
+
+func (rp RegularPolygon) Transform(m Matrix) Shape {
+	return rp.Polygon().Transform(m)
+}
+
+func (e Ellipse) Area() float64 {
+	return math.Pi * e.RadiusX * e.RadiusY
+}
+
+// Perimeter uses Ramanujan's second approximation; an ellipse has no
+// closed-form perimeter.
+func (e Ellipse) Perimeter() float64 {
+	a, b := e.RadiusX, e.RadiusY
+	if a+b == 0 {
+		return 0
+	}
+	h := (a - b) * (a - b) / ((a + b) * (a + b))
+	return math.Pi * (a + b) * (1 + 3*h/(10+math.Sqrt(4-3*h)))
+}
+
+func (e Ellipse) BoundingBox() Bounds {
+	sin, cos := math.Sincos(e.Rotation)
+	halfW := math.Hypot(e.RadiusX*cos, e.RadiusY*sin)
+	halfH := math.Hypot(e.RadiusX*sin, e.RadiusY*cos)
+	return Bounds{
+		Min: Point{e.Center.X - halfW, e.Center.Y - halfH},
+		Max: Point{e.Center.X + halfW, e.Center.Y + halfH},
+	}
+}
+
+func (e Ellipse) Contains(p Point) bool {
+	if e.RadiusX == 0 || e.RadiusY == 0 {
+		return false
+	}
+	sin, cos := math.Sincos(-e.Rotation)
+	dx, dy := p.X-e.Center.X, p.Y-e.Center.Y
+	x, y := dx*cos-dy*sin, dx*sin+dy*cos
+	return (x*x)/(e.RadiusX*e.RadiusX)+(y*y)/(e.RadiusY*e.RadiusY) <= 1
+}
+
+func (e Ellipse) Centroid() Point {
+	return e.Center
+}
+
+// Transform maps the ellipse through m. The image of an ellipse under an
+// affine map is again an ellipse; its radii and rotation come from the
+// singular value decomposition of the combined linear part.
+func (e Ellipse) Transform(m Matrix) Shape {
+	sin, cos := math.Sincos(e.Rotation)
+	a := (m.A*cos + m.C*sin) * e.RadiusX
+	b := (-m.A*sin + m.C*cos) * e.RadiusY
+	c := (m.B*cos + m.D*sin) * e.RadiusX
+	d := (-m.B*sin + m.D*cos) * e.RadiusY
+
+	ee, f := (a+d)/2, (a-d)/2
+	g, h := (c+b)/2, (c-b)/2
+	q, r := math.Hypot(ee, h), math.Hypot(f, g)
+	a1, a2 := math.Atan2(g, f), math.Atan2(h, ee)
+
+	return Ellipse{
+		Center:   m.Apply(e.Center),
+		RadiusX:  q + r,
+		RadiusY:  math.Abs(q - r),
+		Rotation: (a2 + a1) / 2,
+	}
+}
+
+func PrintShapeInfo(s Shape) {
+	logf("Tipe: %T\n", s)
+	logf("  Area: %.2f\n", s.Area())
+	logf("  Perimeter: %.2f\n", s.Perimeter())
+
+	if b, ok := s.(Bounded); ok {
+		logf("  Bounding box: %v\n", b.BoundingBox())
+	}
+	if c, ok := s.(Centered); ok {
+		centroid := c.Centroid()
+		logf("  Centroid: (%.2f, %.2f)\n", centroid.X, centroid.Y)
+		if ct, ok := s.(Container); ok {
+			logf("  Memuat centroid-nya sendiri? %t\n", ct.Contains(centroid))
+		}
+	}
+	if _, ok := s.(SolidShape); ok {
+		logln("  Memenuhi interface gabungan SolidShape")
+	}
+}
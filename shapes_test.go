@@ -0,0 +1,44 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRegularPolygonDegenerateSides(t *testing.T) {
+	for _, sides := range []int{-5, -1, 0, 1, 2} {
+		rp := RegularPolygon{Sides: sides, Radius: 3}
+		if got := len(rp.Polygon().Vertices); got != 0 {
+			t.Errorf("Sides=%d: %d titik sudut, mau 0", sides, got)
+		}
+		if rp.Area() != 0 || rp.Perimeter() != 0 {
+			t.Errorf("Sides=%d: luas %v, keliling %v, mau 0", sides, rp.Area(), rp.Perimeter())
+		}
+		if rp.Contains(Point{}) {
+			t.Errorf("Sides=%d: Contains(0,0) = true", sides)
+		}
+		rp.BoundingBox()
+		rp.Transform(TranslateMatrix(1, 1))
+	}
+}
+
+func TestRegularPolygonVertices(t *testing.T) {
+	tests := []struct {
+		sides int
+		area  float64
+	}{
+		{3, 3 * math.Sqrt(3) / 4},
+		{4, 2},
+		{6, 3 * math.Sqrt(3) / 2},
+	}
+	for _, tt := range tests {
+		rp := RegularPolygon{Sides: tt.sides, Radius: 1}
+		poly := rp.Polygon()
+		if len(poly.Vertices) != tt.sides {
+			t.Fatalf("Sides=%d: %d titik sudut", tt.sides, len(poly.Vertices))
+		}
+		if math.Abs(poly.Area()-tt.area) > 1e-9 || math.Abs(rp.Area()-tt.area) > 1e-9 {
+			t.Errorf("Sides=%d: luas poligon %v, rumus %v, mau %v", tt.sides, poly.Area(), rp.Area(), tt.area)
+		}
+	}
+}
@@ -0,0 +1,736 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// A slice is three words: a pointer into a backing array, a length and a
+// capacity. sliceExample only prints the last two, which hides the part
+// that surprises people: slices whose pointers land in the same array
+// see each other's writes, and append either writes into spare capacity
+// or copies everything to a new array. The explorer runs a script of
+// slice operations and records all three words after every step.
+
+var (
+	ErrSliceBounds  = errors.New("indeks slice di luar batas")
+	ErrUnknownSlice = errors.New("slice tidak dikenal")
+)
+
+// SliceState is one slice header at one point of a script.
+type SliceState struct {
+	Name   string
+	Nil    bool
+	Array  string // label of the backing array, empty when cap is 0
+	Offset int    // index of the first element inside the array
+	Len    int
+	Cap    int
+}
+
+// SliceStep is the statement that was run, what it did, and every slice
+// afterwards.
+type SliceStep struct {
+	Op      string
+	Notes   []string
+	Slices  []SliceState
+	Arrays  []BackingArray
+	Aliases []SliceAlias
+}
+
+type BackingArray struct {
+	Label string
+	Cells []string
+}
+
+// SliceAlias is a pair of slices with the same backing array. Overlap is
+// true when their visible elements share memory; otherwise only the
+// capacity of one reaches into the other, and an append is what makes
+// them collide.
+type SliceAlias struct {
+	A, B    string
+	Array   string
+	Overlap bool
+}
+
+type SliceExplorer[T any] struct {
+	Steps  []SliceStep
+	names  []string
+	vars   map[string][]T
+	arrays []arrayRegion
+	// keep holds every slice ever created so that no backing array is
+	// freed and its address handed to a later allocation mid-script.
+	keep [][]T
+	err  error
+}
+
+type arrayRegion struct {
+	label      string
+	start, end uintptr
+}
+
+func init() {
+	registerCommand(command{
+		Name:  "slices",
+		Usage: "slices [-list] [SKENARIO...]",
+		Run:   slicesCommand,
+	})
+}
+
+func NewSliceExplorer[T any]() *SliceExplorer[T] {
+	return &SliceExplorer[T]{vars: map[string][]T{}}
+}
+
+// Err returns the first error of the script. Once a step fails the
+// remaining ones are skipped, so a scenario can be written as a plain
+// list of calls and checked once at the end.
+func (e *SliceExplorer[T]) Err() error {
+	return e.err
+}
+
+func (e *SliceExplorer[T]) Literal(name string, values ...T) {
+	if e.err != nil {
+		return
+	}
+	s := make([]T, len(values))
+	copy(s, values)
+	op := fmt.Sprintf("%s %s %#v", name, e.assign(name), s)
+	e.store(name, s)
+	e.record(op)
+}
+
+func (e *SliceExplorer[T]) Make(name string, length, capacity int) {
+	if e.err != nil {
+		return
+	}
+	if length < 0 || length > capacity {
+		e.err = fmt.Errorf("%w: make dengan len %d dan cap %d", ErrSliceBounds, length, capacity)
+		return
+	}
+	op := fmt.Sprintf("%s %s make(%T, %d, %d)", name, e.assign(name), []T(nil), length, capacity)
+	e.store(name, make([]T, length, capacity))
+	e.record(op)
+}
+
+// Slice runs dst := src[lo:hi]. Like Go itself it accepts hi up to the
+// capacity of src, not just its length.
+func (e *SliceExplorer[T]) Slice(dst, src string, lo, hi int) {
+	if e.err != nil {
+		return
+	}
+	s, ok := e.get(src)
+	if !ok {
+		return
+	}
+	if lo < 0 || lo > hi || hi > cap(s) {
+		e.err = fmt.Errorf("%w: %s[%d:%d] dengan cap %d", ErrSliceBounds, src, lo, hi, cap(s))
+		return
+	}
+	op := fmt.Sprintf("%s %s %s[%d:%d]", dst, e.assign(dst), src, lo, hi)
+	var notes []string
+	if hi > len(s) {
+		notes = append(notes, fmt.Sprintf("batas atas %d melewati len %d: elemen di kapasitas cadangan ikut terlihat", hi, len(s)))
+	}
+	e.store(dst, s[lo:hi])
+	e.record(op, notes...)
+}
+
+// FullSlice runs dst := src[lo:hi:limit], which also caps the capacity of
+// the result at limit-lo.
+func (e *SliceExplorer[T]) FullSlice(dst, src string, lo, hi, limit int) {
+	if e.err != nil {
+		return
+	}
+	s, ok := e.get(src)
+	if !ok {
+		return
+	}
+	if lo < 0 || lo > hi || hi > limit || limit > cap(s) {
+		e.err = fmt.Errorf("%w: %s[%d:%d:%d] dengan cap %d", ErrSliceBounds, src, lo, hi, limit, cap(s))
+		return
+	}
+	op := fmt.Sprintf("%s %s %s[%d:%d:%d]", dst, e.assign(dst), src, lo, hi, limit)
+	note := fmt.Sprintf("cap dibatasi menjadi %d: append berikutnya lewat %s pasti membuat array baru begitu len mencapai cap", limit-lo, dst)
+	e.store(dst, s[lo:hi:limit])
+	e.record(op, note)
+}
+
+func (e *SliceExplorer[T]) Append(dst, src string, values ...T) {
+	if e.err != nil {
+		return
+	}
+	s, ok := e.get(src)
+	if !ok {
+		return
+	}
+	args := make([]string, len(values))
+	for i, v := range values {
+		args[i] = fmt.Sprintf("%#v", v)
+	}
+	op := fmt.Sprintf("%s %s append(%s, %s)", dst, e.assign(dst), src, strings.Join(args, ", "))
+	e.appendTo(dst, src, op, s, append(s, values...))
+}
+
+func (e *SliceExplorer[T]) AppendSlice(dst, src, other string) {
+	if e.err != nil {
+		return
+	}
+	s, ok := e.get(src)
+	if !ok {
+		return
+	}
+	o, ok := e.get(other)
+	if !ok {
+		return
+	}
+	op := fmt.Sprintf("%s %s append(%s, %s...)", dst, e.assign(dst), src, other)
+	e.appendTo(dst, src, op, s, append(s, o...))
+}
+
+func (e *SliceExplorer[T]) appendTo(dst, src, op string, before, after []T) {
+	var notes []string
+	if cap(after) == cap(before) && len(after) > len(before) {
+		notes = append(notes, fmt.Sprintf("kapasitas cukup (len %d, cap %d): elemen baru ditulis ke array yang sama", len(before), cap(before)))
+		written := after[len(before):]
+		if seen := e.seenBy(written, dst, src); len(seen) > 0 {
+			notes = append(notes, "menimpa elemen yang terlihat lewat "+strings.Join(seen, ", "))
+		}
+	} else if cap(after) != cap(before) {
+		notes = append(notes, fmt.Sprintf("kapasitas habis (len %d, cap %d): array baru dengan cap %d, %d elemen lama disalin",
+			len(before), cap(before), cap(after), len(before)))
+	}
+	e.store(dst, after)
+	e.record(op, notes...)
+}
+
+// Copy runs copy(dst, src). Overlapping ranges are fine: copy behaves
+// like memmove.
+func (e *SliceExplorer[T]) Copy(dst, src string) {
+	if e.err != nil {
+		return
+	}
+	d, ok := e.get(dst)
+	if !ok {
+		return
+	}
+	s, ok := e.get(src)
+	if !ok {
+		return
+	}
+	overlap := overlaps(window(d), window(s))
+	n := copy(d, s)
+	notes := []string{fmt.Sprintf("%d elemen disalin; len dan cap %s tidak berubah", n, dst)}
+	if overlap {
+		notes = append(notes, "sumber dan tujuan tumpang tindih; copy tetap benar karena menyalin seperti memmove")
+	}
+	if seen := e.seenBy(d[:n], dst, src); len(seen) > 0 {
+		notes = append(notes, "perubahan terlihat juga lewat "+strings.Join(seen, ", "))
+	}
+	e.record(fmt.Sprintf("copy(%s, %s)", dst, src), notes...)
+}
+
+func (e *SliceExplorer[T]) Set(name string, i int, v T) {
+	if e.err != nil {
+		return
+	}
+	s, ok := e.get(name)
+	if !ok {
+		return
+	}
+	if i < 0 || i >= len(s) {
+		e.err = fmt.Errorf("%w: %s[%d] dengan len %d", ErrSliceBounds, name, i, len(s))
+		return
+	}
+	s[i] = v
+	var notes []string
+	if seen := e.seenBy(s[i:i+1], name); len(seen) > 0 {
+		notes = append(notes, "perubahan terlihat juga lewat "+strings.Join(seen, ", "))
+	}
+	e.record(fmt.Sprintf("%s[%d] = %#v", name, i, v), notes...)
+}
+
+func (e *SliceExplorer[T]) get(name string) ([]T, bool) {
+	s, ok := e.vars[name]
+	if !ok {
+		e.err = fmt.Errorf("%w: %s", ErrUnknownSlice, name)
+	}
+	return s, ok
+}
+
+func (e *SliceExplorer[T]) assign(name string) string {
+	if _, ok := e.vars[name]; ok {
+		return "="
+	}
+	return ":="
+}
+
+func (e *SliceExplorer[T]) store(name string, s []T) {
+	if _, ok := e.vars[name]; !ok {
+		e.names = append(e.names, name)
+	}
+	e.vars[name] = s
+	e.keep = append(e.keep, s)
+}
+
+func elemSize[T any]() uintptr {
+	var zero T
+	return max(unsafe.Sizeof(zero), 1)
+}
+
+// memRange is a half-open byte range [0]..[1).
+type memRange [2]uintptr
+
+func window[T any](s []T) memRange {
+	p := uintptr(unsafe.Pointer(unsafe.SliceData(s)))
+	return memRange{p, p + uintptr(len(s))*elemSize[T]()}
+}
+
+func capacityRange[T any](s []T) memRange {
+	p := uintptr(unsafe.Pointer(unsafe.SliceData(s)))
+	return memRange{p, p + uintptr(cap(s))*elemSize[T]()}
+}
+
+func overlaps(a, b memRange) bool {
+	return a[0] < b[1] && b[0] < a[1] && a[0] < a[1] && b[0] < b[1]
+}
+
+// seenBy lists the other slices whose visible elements include part of
+// written, as index expressions such as b[3] or b[0:2].
+func (e *SliceExplorer[T]) seenBy(written []T, exclude ...string) []string {
+	w := window(written)
+	size := elemSize[T]()
+	var seen []string
+	for _, name := range e.names {
+		if slices.Contains(exclude, name) {
+			continue
+		}
+		v := window(e.vars[name])
+		if !overlaps(w, v) {
+			continue
+		}
+		lo := int((max(w[0], v[0]) - v[0]) / size)
+		hi := int((min(w[1], v[1]) - v[0]) / size)
+		if hi-lo == 1 {
+			seen = append(seen, fmt.Sprintf("%s[%d]", name, lo))
+		} else {
+			seen = append(seen, fmt.Sprintf("%s[%d:%d]", name, lo, hi))
+		}
+	}
+	return seen
+}
+
+// region returns the index of the known array that contains r, widening
+// it if r reaches past its ends, or registers a new one.
+func (e *SliceExplorer[T]) region(r memRange) int {
+	for i, a := range e.arrays {
+		if r[0] < a.end && a.start < r[1] {
+			e.arrays[i].start = min(a.start, r[0])
+			e.arrays[i].end = max(a.end, r[1])
+			return i
+		}
+	}
+	e.arrays = append(e.arrays, arrayRegion{label: arrayLabel(len(e.arrays)), start: r[0], end: r[1]})
+	return len(e.arrays) - 1
+}
+
+func arrayLabel(i int) string {
+	label := string(rune('A' + i%26))
+	if i >= 26 {
+		label += strconv.Itoa(i / 26)
+	}
+	return label
+}
+
+func (e *SliceExplorer[T]) record(op string, notes ...string) {
+	size := elemSize[T]()
+	step := SliceStep{Op: op, Notes: notes}
+
+	owner := make([]int, len(e.names))
+	for i, name := range e.names {
+		owner[i] = -1
+		if s := e.vars[name]; cap(s) > 0 {
+			owner[i] = e.region(capacityRange(s))
+		}
+	}
+	used := map[int]bool{}
+	for i, name := range e.names {
+		s := e.vars[name]
+		st := SliceState{Name: name, Nil: s == nil, Len: len(s), Cap: cap(s)}
+		if a := owner[i]; a >= 0 {
+			st.Array = e.arrays[a].label
+			st.Offset = int((capacityRange(s)[0] - e.arrays[a].start) / size)
+			used[a] = true
+		}
+		step.Slices = append(step.Slices, st)
+	}
+
+	for a, region := range e.arrays {
+		if !used[a] {
+			continue
+		}
+		cells := make([]string, (region.end-region.start)/size)
+		for i := range cells {
+			cells[i] = "?"
+		}
+		for _, s := range e.keep {
+			r := capacityRange(s)
+			if cap(s) == 0 || r[0] < region.start || r[1] > region.end {
+				continue
+			}
+			off := int((r[0] - region.start) / size)
+			for i, v := range s[:cap(s)] {
+				cells[off+i] = sliceCell(v)
+			}
+		}
+		step.Arrays = append(step.Arrays, BackingArray{Label: region.label, Cells: cells})
+	}
+
+	for i, a := range step.Slices {
+		for _, b := range step.Slices[i+1:] {
+			if a.Array == "" || a.Array != b.Array {
+				continue
+			}
+			overlap := a.Offset < b.Offset+b.Len && b.Offset < a.Offset+a.Len
+			step.Aliases = append(step.Aliases, SliceAlias{A: a.Name, B: b.Name, Array: a.Array, Overlap: overlap})
+		}
+	}
+	step.Notes = append(step.Notes, aliasChanges(e.lastAliases(), step.Aliases)...)
+	e.Steps = append(e.Steps, step)
+}
+
+func (e *SliceExplorer[T]) lastAliases() []SliceAlias {
+	if len(e.Steps) == 0 {
+		return nil
+	}
+	return e.Steps[len(e.Steps)-1].Aliases
+}
+
+// aliasChanges describes the pairs that started or stopped sharing an
+// array between two steps.
+func aliasChanges(before, after []SliceAlias) []string {
+	key := func(a SliceAlias) string {
+		return a.A + "\x00" + a.B
+	}
+	old := map[string]bool{}
+	for _, a := range before {
+		old[key(a)] = true
+	}
+	now := map[string]bool{}
+	var notes []string
+	for _, a := range after {
+		now[key(a)] = true
+		if !old[key(a)] {
+			notes = append(notes, fmt.Sprintf("%s dan %s sekarang berbagi array %s", a.A, a.B, a.Array))
+		}
+	}
+	for _, a := range before {
+		if !now[key(a)] {
+			notes = append(notes, fmt.Sprintf("%s dan %s tidak lagi berbagi array", a.A, a.B))
+		}
+	}
+	return notes
+}
+
+func sliceCell(v any) string {
+	if s, ok := v.(string); ok {
+		if s == "" {
+			return `""`
+		}
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+// Write draws every backing array as a row of cells with one bar per
+// slice underneath: '=' marks elements up to len, '-' the spare capacity.
+//
+//	     0   1   2   3
+//	A  │ 1 │ 2 │ 3 │ 0 │
+//	a  [===========|---]  len 3, cap 4 (A[0:3:4])
+func (st SliceStep) Write(w io.Writer) {
+	fmt.Fprintln(w, st.Op)
+	for _, n := range st.Notes {
+		fmt.Fprintf(w, "  → %s\n", n)
+	}
+
+	nameW := 1
+	for _, s := range st.Slices {
+		nameW = max(nameW, displayLen(s.Name))
+	}
+	prefix := func(label string) string {
+		return "  " + padRight(label, nameW) + "  "
+	}
+
+	for _, arr := range st.Arrays {
+		cw := 3
+		for _, c := range arr.Cells {
+			cw = max(cw, displayLen(c)+2)
+		}
+		var idx, row strings.Builder
+		idx.WriteString(prefix(""))
+		row.WriteString(prefix(arr.Label) + "│")
+		for i, c := range arr.Cells {
+			idx.WriteString(" " + centre(strconv.Itoa(i), cw))
+			row.WriteString(centre(c, cw) + "│")
+		}
+		fmt.Fprintln(w, strings.TrimRight(idx.String(), " "))
+		fmt.Fprintln(w, row.String())
+
+		for _, s := range st.Slices {
+			if s.Array != arr.Label {
+				continue
+			}
+			fmt.Fprintf(w, "%s%s  len %d, cap %d (%s[%d:%d:%d])\n", prefix(s.Name), sliceBar(s, len(arr.Cells), cw),
+				s.Len, s.Cap, s.Array, s.Offset, s.Offset+s.Len, s.Offset+s.Cap)
+		}
+	}
+	for _, s := range st.Slices {
+		switch {
+		case s.Nil:
+			fmt.Fprintf(w, "%snil, len 0, cap 0\n", prefix(s.Name))
+		case s.Array == "":
+			fmt.Fprintf(w, "%skosong, len 0, cap 0 (tanpa array)\n", prefix(s.Name))
+		}
+	}
+
+	if len(st.Aliases) > 0 {
+		pairs := make([]string, len(st.Aliases))
+		for i, a := range st.Aliases {
+			kind := "hanya kapasitas"
+			if a.Overlap {
+				kind = "elemen tumpang tindih"
+			}
+			pairs[i] = fmt.Sprintf("%s↔%s (%s)", a.A, a.B, kind)
+		}
+		fmt.Fprintf(w, "  Berbagi array: %s\n", strings.Join(pairs, ", "))
+	}
+}
+
+func sliceBar(s SliceState, cells, cw int) string {
+	line := []rune(strings.Repeat(" ", cells*(cw+1)+1))
+	start := s.Offset * (cw + 1)
+	lenEnd := (s.Offset + s.Len) * (cw + 1)
+	capEnd := (s.Offset + s.Cap) * (cw + 1)
+	for p := start; p <= capEnd; p++ {
+		line[p] = '-'
+		if p < lenEnd {
+			line[p] = '='
+		}
+	}
+	line[start] = '['
+	line[capEnd] = ']'
+	if s.Len > 0 && s.Len < s.Cap {
+		line[lenEnd] = '|'
+	}
+	return strings.TrimRight(string(line), " ")
+}
+
+func WriteSliceSteps(w io.Writer, steps []SliceStep) {
+	for i, st := range steps {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%d. ", i+1)
+		st.Write(w)
+	}
+}
+
+// GrowthEvent is one reallocation seen while appending one element at a
+// time: the append that made len reach Len moved the slice from OldCap to
+// NewCap.
+type GrowthEvent struct {
+	Len    int
+	OldCap int
+	NewCap int
+}
+
+func SliceGrowth[T any](n int) []GrowthEvent {
+	var s []T
+	var zero T
+	var events []GrowthEvent
+	for range n {
+		old := cap(s)
+		s = append(s, zero)
+		if cap(s) != old {
+			events = append(events, GrowthEvent{Len: len(s), OldCap: old, NewCap: cap(s)})
+		}
+	}
+	return events
+}
+
+func writeGrowthTable(w io.Writer, events []GrowthEvent, size uintptr) {
+	fmt.Fprintf(w, "  %6s  %8s  %8s  %6s  %8s\n", "len", "cap lama", "cap baru", "faktor", "byte")
+	for _, ev := range events {
+		factor := "-"
+		if ev.OldCap > 0 {
+			factor = fmt.Sprintf("%.2f", float64(ev.NewCap)/float64(ev.OldCap))
+		}
+		fmt.Fprintf(w, "  %6d  %8d  %8d  %6s  %8d\n", ev.Len, ev.OldCap, ev.NewCap, factor, uintptr(ev.NewCap)*size)
+	}
+}
+
+func growthSummary(events []GrowthEvent) string {
+	caps := []string{"0"}
+	for _, ev := range events {
+		caps = append(caps, strconv.Itoa(ev.NewCap))
+	}
+	return strings.Join(caps, " → ")
+}
+
+type sliceScenario struct {
+	Name  string
+	Title string
+	Run   func(w io.Writer) error
+}
+
+var sliceScenarios = []sliceScenario{
+	{"reslice", "Reslice berbagi array dengan sumbernya", resliceScenario},
+	{"append-colors", "append(colors, moreColors...) dari sliceExample", appendColorsScenario},
+	{"append-sibling", "Dua append dari slice yang sama saling menimpa", appendSiblingScenario},
+	{"full-slice", "Ekspresi s[a:b:c] memisahkan hasil append", fullSliceScenario},
+	{"copy", "copy menyalin elemen, bukan header", copyScenario},
+	{"growth", "Kebijakan pertumbuhan kapasitas append", growthScenario},
+}
+
+func resliceScenario(w io.Writer) error {
+	e := NewSliceExplorer[int]()
+	e.Literal("primes", 2, 3, 5, 7, 11, 13)
+	e.Slice("s", "primes", 1, 4)
+	e.Set("s", 0, 99)
+	e.Slice("t", "s", 2, 5)
+	e.Set("t", 2, 42)
+	WriteSliceSteps(w, e.Steps)
+	return e.Err()
+}
+
+func appendColorsScenario(w io.Writer) error {
+	e := NewSliceExplorer[string]()
+	e.Literal("colors", "Merah", "Hijau", "Biru")
+	e.Literal("moreColors", "Kuning", "Ungu")
+	e.AppendSlice("allColors", "colors", "moreColors")
+	e.Set("allColors", 0, "Jingga")
+	e.Make("roomy", 3, 8)
+	e.Copy("roomy", "colors")
+	e.AppendSlice("allRoomy", "roomy", "moreColors")
+	e.Set("roomy", 0, "Hitam")
+	WriteSliceSteps(w, e.Steps)
+	return e.Err()
+}
+
+func appendSiblingScenario(w io.Writer) error {
+	e := NewSliceExplorer[int]()
+	e.Make("a", 3, 8)
+	e.Append("b", "a", 1)
+	e.Append("c", "a", 2)
+	WriteSliceSteps(w, e.Steps)
+	return e.Err()
+}
+
+func fullSliceScenario(w io.Writer) error {
+	e := NewSliceExplorer[int]()
+	e.Make("a", 3, 8)
+	e.FullSlice("a3", "a", 0, 3, 3)
+	e.Append("b", "a3", 1)
+	e.Append("c", "a3", 2)
+	WriteSliceSteps(w, e.Steps)
+	return e.Err()
+}
+
+func copyScenario(w io.Writer) error {
+	e := NewSliceExplorer[int]()
+	e.Literal("src", 1, 2, 3, 4, 5)
+	e.Make("dst", 3, 3)
+	e.Copy("dst", "src")
+	e.Slice("tail", "src", 1, 5)
+	e.Copy("tail", "src")
+	WriteSliceSteps(w, e.Steps)
+	return e.Err()
+}
+
+func growthScenario(w io.Writer) error {
+	e := NewSliceExplorer[int]()
+	e.Literal("a")
+	e.Append("a", "a", 1, 2, 3, 4, 5)
+	e.Append("a", "a", 6)
+	e.Append("a", "a", 7)
+	WriteSliceSteps(w, e.Steps)
+	if err := e.Err(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nappend satu per satu ke []int:")
+	writeGrowthTable(w, SliceGrowth[int](2048), elemSize[int]())
+	fmt.Fprintln(w, "Sampai cap 256 kapasitas digandakan; setelahnya tumbuh ±1,25x ditambah 192,")
+	fmt.Fprintln(w, "lalu dibulatkan ke kelas ukuran alokator, sehingga faktornya tidak selalu bulat.")
+	fmt.Fprintln(w, "\nUkuran elemen ikut menentukan pembulatan:")
+	fmt.Fprintln(w, "  []byte  :", growthSummary(SliceGrowth[byte](100)))
+	fmt.Fprintln(w, "  []int   :", growthSummary(SliceGrowth[int](100)))
+	fmt.Fprintln(w, "  []string:", growthSummary(SliceGrowth[string](100)))
+	return nil
+}
+
+func slicesCommand(args []string) error {
+	fs := flag.NewFlagSet("slices", flag.ContinueOnError)
+	list := fs.Bool("list", false, "tampilkan daftar skenario")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *list {
+		for _, sc := range sliceScenarios {
+			fmt.Printf("%-15s %s\n", sc.Name, sc.Title)
+		}
+		return nil
+	}
+
+	selected := sliceScenarios
+	if fs.NArg() > 0 {
+		selected = nil
+		for _, name := range fs.Args() {
+			sc, ok := findSliceScenario(name)
+			if !ok {
+				return fmt.Errorf("%w: skenario %q tidak ada (lihat slices -list)", errUsage, name)
+			}
+			selected = append(selected, sc)
+		}
+	}
+	for i, sc := range selected {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("=== %s: %s ===\n", sc.Name, sc.Title)
+		if err := sc.Run(os.Stdout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findSliceScenario(name string) (sliceScenario, bool) {
+	for _, sc := range sliceScenarios {
+		if sc.Name == name {
+			return sc, true
+		}
+	}
+	return sliceScenario{}, false
+}
+
+func sliceAliasExample() {
+	for _, name := range []string{"append-colors", "append-sibling"} {
+		sc, _ := findSliceScenario(name)
+		logf("Skenario %s: %s\n", sc.Name, sc.Title)
+		var err error
+		logOutput(func(w io.Writer) { err = sc.Run(w) })
+		if err != nil {
+			logln("Error:", err)
+		}
+		logln()
+	}
+	logln("Pertumbuhan cap []int:", growthSummary(SliceGrowth[int](1024)))
+	logln("(Jalankan `slices` untuk semua skenario dan tabel pertumbuhan lengkap.)")
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"belajar-golang/internal/table"
+)
+
+// Populations rounded from the 2020 census, for demonstration only.
+//
+//go:embed data/cities.csv
+var sampleCitiesCSV string
+
+func init() {
+	registerCommand(command{
+		Name:  "query",
+		Usage: "query [-format text|csv|json|markdown] \"SELECT ... FROM cities|'file.csv'|'file.json' ...\"",
+		Run:   queryCommand,
+	})
+}
+
+func SampleCities() *table.Table {
+	t, err := table.LoadTableCSV(strings.NewReader(sampleCitiesCSV))
+	if err != nil {
+		panic("data/cities.csv rusak: " + err.Error())
+	}
+	return t
+}
+
+// RunSQL runs query against the built-in "cities" table or, when FROM names
+// a .csv or .json file, against that file.
+func RunSQL(query string) (*table.Table, error) {
+	stmt, err := table.ParseSQL(query)
+	if err != nil {
+		return nil, err
+	}
+	var t *table.Table
+	switch strings.ToLower(stmt.From) {
+	case "", "cities", "kota":
+		t = SampleCities()
+	default:
+		if t, err = table.LoadTableFile(stmt.From); err != nil {
+			return nil, err
+		}
+	}
+	return stmt.On(t).Run()
+}
+
+func queryCommand(args []string) error {
+	fs := flag.NewFlagSet("query", flag.ContinueOnError)
+	format := fs.String("format", "text", "format keluaran: text, csv, json atau markdown")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%w: query SQL wajib diisi", errUsage)
+	}
+
+	result, err := RunSQL(strings.Join(fs.Args(), " "))
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "text":
+		return result.Format(os.Stdout)
+	case "csv":
+		return result.WriteCSV(os.Stdout)
+	case "json":
+		return result.WriteJSON(os.Stdout)
+	case "markdown":
+		return result.WriteMarkdown(os.Stdout)
+	}
+	return fmt.Errorf("%w: format %q tidak dikenal", errUsage, *format)
+}
+
+func tableExample() {
+	cities := SampleCities()
+	var cols strings.Builder
+	for _, c := range cities.Columns {
+		fmt.Fprintf(&cols, " %s(%s)", c.Name, c.Type)
+	}
+	logf("Kolom:%s", cols.String())
+	logf("%d kota dimuat dari data/cities.csv\n\n", len(cities.Rows))
+
+	logln("Tiga kota terbesar di luar Jawa (API fluent):")
+	top, err := cities.Query().
+		Where("island", "!=", "Jawa").
+		OrderByDesc("population").
+		Limit(3).
+		Select("name", "province", "population").
+		Run()
+	if err != nil {
+		logln("Error:", err)
+		return
+	}
+	logOutput(func(w io.Writer) { top.Format(w) })
+
+	logln("\nPenduduk per pulau (SQL):")
+	byIsland, err := RunSQL(`SELECT island, COUNT(*) AS cities, SUM(population) AS total, AVG(area_km2) AS avg_area
+		FROM cities GROUP BY island ORDER BY total DESC`)
+	if err != nil {
+		logln("Error:", err)
+		return
+	}
+	logOutput(func(w io.Writer) { byIsland.Format(w) })
+
+	if _, err := RunSQL("SELECT name FROM cities WHERE mayor = 'x'"); err != nil {
+		logln("\nQuery dengan kolom salah:", err)
+	}
+}
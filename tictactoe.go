@@ -0,0 +1,647 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tic-tac-toe grows the [][]string board from sliceExample into a full
+// game: an N×N board where K marks in a row win, players behind an
+// interface, and a minimax AI with alpha-beta pruning.
+
+type Mark int8
+
+const (
+	Empty Mark = iota
+	MarkX
+	MarkO
+)
+
+type Move struct {
+	Row, Col int
+}
+
+type Board struct {
+	Size  int
+	K     int
+	cells [][]Mark
+	empty int
+	moves []Move
+}
+
+// A TicTacToePlayer picks the next move for mark on b. Implementations
+// must not modify b.
+type TicTacToePlayer interface {
+	Name() string
+	ChooseMove(b *Board, mark Mark) (Move, error)
+}
+
+type HumanPlayer struct {
+	In  *bufio.Scanner
+	Out io.Writer
+}
+
+type RandomPlayer struct {
+	Rand *rand.Rand
+}
+
+// MinimaxPlayer searches the game tree with alpha-beta pruning. A MaxDepth
+// of 0 searches to the end of the game, which is only practical on 3×3;
+// bigger boards need a limit, after which positions are scored by counting
+// open lines.
+type MinimaxPlayer struct {
+	MaxDepth int
+	Nodes    int // positions visited by the last ChooseMove
+}
+
+type TicTacToeGame struct {
+	Board   *Board
+	Players [2]TicTacToePlayer // X moves first
+	Out     io.Writer
+}
+
+var (
+	ErrCellTaken   = errors.New("kotak sudah terisi")
+	ErrOutOfBounds = errors.New("posisi di luar papan")
+	ErrGameOver    = errors.New("permainan sudah selesai")
+	ErrQuit        = errors.New("pemain keluar dari permainan")
+)
+
+// heuristicLimit bounds evaluateBoard. A won position scores
+// winScore() - ply, and a search never goes deeper than the number of
+// cells, so every win outranks every estimate. It leaves room to add two
+// scores without overflowing a 32-bit int.
+const heuristicLimit = 1 << 29
+
+// maxTicTacToeSize caps -n: beyond it even a depth-limited search takes
+// too long to be playable, and the board no longer fits a terminal.
+const maxTicTacToeSize = 10
+
+var lineDirections = [4]Move{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+
+func init() {
+	registerCommand(command{
+		Name:  "tictactoe",
+		Usage: "tictactoe [-n 3] [-k 3] [-x human|ai|random] [-o human|ai|random] [-depth N] [-bench]",
+		Run:   ticTacToeCommand,
+	})
+}
+
+func (m Mark) String() string {
+	switch m {
+	case MarkX:
+		return "X"
+	case MarkO:
+		return "O"
+	}
+	return "_"
+}
+
+func (m Mark) Opponent() Mark {
+	switch m {
+	case MarkX:
+		return MarkO
+	case MarkO:
+		return MarkX
+	}
+	return Empty
+}
+
+func (m Move) String() string {
+	return fmt.Sprintf("(%d,%d)", m.Row+1, m.Col+1)
+}
+
+func NewBoard(size, k int) (*Board, error) {
+	if size < 1 || k < 1 || k > size {
+		return nil, fmt.Errorf("ukuran papan %d dengan %d berderet tidak valid", size, k)
+	}
+	cells := make([][]Mark, size)
+	for i := range cells {
+		cells[i] = make([]Mark, size)
+	}
+	return &Board{Size: size, K: k, cells: cells, empty: size * size}, nil
+}
+
+// BoardFromRows reads the same layout sliceExample prints: "X", "O" and
+// "_" (or "") for an empty cell. X always starts, so the board must hold
+// as many X as O, or one X more.
+func BoardFromRows(rows [][]string, k int) (*Board, error) {
+	b, err := NewBoard(len(rows), k)
+	if err != nil {
+		return nil, err
+	}
+	for r, row := range rows {
+		if len(row) != b.Size {
+			return nil, fmt.Errorf("baris %d memiliki %d kolom, seharusnya %d", r+1, len(row), b.Size)
+		}
+		for c, cell := range row {
+			switch strings.ToUpper(cell) {
+			case "X":
+				b.set(Move{r, c}, MarkX)
+			case "O":
+				b.set(Move{r, c}, MarkO)
+			case "_", "", " ":
+			default:
+				return nil, fmt.Errorf("isi kotak tidak dikenal %q pada %v", cell, Move{r, c})
+			}
+		}
+	}
+	xCount, oCount := b.count(MarkX), b.count(MarkO)
+	if xCount != oCount && xCount != oCount+1 {
+		return nil, fmt.Errorf("papan berisi %d X dan %d O; X selalu mulai, jadi X harus sama dengan O atau satu lebih banyak", xCount, oCount)
+	}
+	return b, nil
+}
+
+func (b *Board) Rows() [][]string {
+	rows := make([][]string, b.Size)
+	for r, row := range b.cells {
+		rows[r] = make([]string, b.Size)
+		for c, m := range row {
+			rows[r][c] = m.String()
+		}
+	}
+	return rows
+}
+
+// winScore is the score of winning on the spot; it grows with the board
+// so that a win found at the deepest ply still beats heuristicLimit.
+func (b *Board) winScore() int {
+	return heuristicLimit + b.Size*b.Size + 1
+}
+
+func (b *Board) Clone() *Board {
+	clone, _ := NewBoard(b.Size, b.K)
+	for r := range b.cells {
+		copy(clone.cells[r], b.cells[r])
+	}
+	clone.empty = b.empty
+	clone.moves = append([]Move(nil), b.moves...)
+	return clone
+}
+
+func (b *Board) inBounds(m Move) bool {
+	return m.Row >= 0 && m.Row < b.Size && m.Col >= 0 && m.Col < b.Size
+}
+
+func (b *Board) Cell(m Move) Mark {
+	if !b.inBounds(m) {
+		return Empty
+	}
+	return b.cells[m.Row][m.Col]
+}
+
+func (b *Board) set(m Move, mark Mark) {
+	if b.cells[m.Row][m.Col] == Empty && mark != Empty {
+		b.empty--
+	} else if b.cells[m.Row][m.Col] != Empty && mark == Empty {
+		b.empty++
+	}
+	b.cells[m.Row][m.Col] = mark
+}
+
+func (b *Board) count(mark Mark) int {
+	n := 0
+	for _, row := range b.cells {
+		for _, m := range row {
+			if m == mark {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Turn is the mark that moves next; X always starts.
+func (b *Board) Turn() Mark {
+	if (b.Size*b.Size-b.empty)%2 == 0 {
+		return MarkX
+	}
+	return MarkO
+}
+
+func (b *Board) Play(m Move) error {
+	if !b.inBounds(m) {
+		return fmt.Errorf("%v: %w", m, ErrOutOfBounds)
+	}
+	if _, over := b.Outcome(); over {
+		return ErrGameOver
+	}
+	if b.cells[m.Row][m.Col] != Empty {
+		return fmt.Errorf("%v: %w", m, ErrCellTaken)
+	}
+	b.set(m, b.Turn())
+	b.moves = append(b.moves, m)
+	return nil
+}
+
+// Undo takes back the last move played with Play.
+func (b *Board) Undo() bool {
+	if len(b.moves) == 0 {
+		return false
+	}
+	last := b.moves[len(b.moves)-1]
+	b.moves = b.moves[:len(b.moves)-1]
+	b.set(last, Empty)
+	return true
+}
+
+func (b *Board) LegalMoves() []Move {
+	moves := make([]Move, 0, b.empty)
+	for r, row := range b.cells {
+		for c, m := range row {
+			if m == Empty {
+				moves = append(moves, Move{r, c})
+			}
+		}
+	}
+	return moves
+}
+
+// lineThrough counts the marks equal to the one at m in the line through m
+// along dir, including m itself.
+func (b *Board) lineThrough(m Move, dir Move) int {
+	mark := b.cells[m.Row][m.Col]
+	count := 1
+	for _, sign := range [2]int{1, -1} {
+		p := Move{m.Row + sign*dir.Row, m.Col + sign*dir.Col}
+		for b.inBounds(p) && b.cells[p.Row][p.Col] == mark {
+			count++
+			p = Move{p.Row + sign*dir.Row, p.Col + sign*dir.Col}
+		}
+	}
+	return count
+}
+
+func (b *Board) completesLine(m Move) bool {
+	if b.cells[m.Row][m.Col] == Empty {
+		return false
+	}
+	for _, dir := range lineDirections {
+		if b.lineThrough(m, dir) >= b.K {
+			return true
+		}
+	}
+	return false
+}
+
+// Outcome reports the winner (Empty for a draw) and whether the game is
+// over.
+func (b *Board) Outcome() (winner Mark, over bool) {
+	for r, row := range b.cells {
+		for c, m := range row {
+			if m != Empty && b.completesLine(Move{r, c}) {
+				return m, true
+			}
+		}
+	}
+	return Empty, b.empty == 0
+}
+
+func (b *Board) String() string {
+	var sb strings.Builder
+	width := len(strconv.Itoa(b.Size))
+	sb.WriteString(strings.Repeat(" ", width+1))
+	for c := range b.Size {
+		fmt.Fprintf(&sb, " %*d", width, c+1)
+	}
+	sb.WriteByte('\n')
+	for r, row := range b.cells {
+		fmt.Fprintf(&sb, "%*d ", width, r+1)
+		for _, m := range row {
+			fmt.Fprintf(&sb, " %*s", width, m)
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func (h HumanPlayer) Name() string {
+	return "Manusia"
+}
+
+// ChooseMove reads "baris kolom" (1-based) until it gets a legal move.
+func (h HumanPlayer) ChooseMove(b *Board, mark Mark) (Move, error) {
+	for {
+		fmt.Fprintf(h.Out, "Giliran %s, masukkan baris dan kolom (mis. 2 3, 'q' untuk keluar): ", mark)
+		if !h.In.Scan() {
+			if err := h.In.Err(); err != nil {
+				return Move{}, err
+			}
+			return Move{}, ErrQuit
+		}
+		line := strings.TrimSpace(h.In.Text())
+		if line == "q" || line == "quit" {
+			return Move{}, ErrQuit
+		}
+
+		fields := strings.FieldsFunc(line, func(r rune) bool { return r == ' ' || r == ',' })
+		if len(fields) != 2 {
+			fmt.Fprintln(h.Out, "Masukkan dua angka: baris dan kolom.")
+			continue
+		}
+		row, errRow := strconv.Atoi(fields[0])
+		col, errCol := strconv.Atoi(fields[1])
+		if errRow != nil || errCol != nil {
+			fmt.Fprintln(h.Out, "Baris dan kolom harus berupa angka.")
+			continue
+		}
+
+		m := Move{row - 1, col - 1}
+		switch {
+		case !b.inBounds(m):
+			fmt.Fprintf(h.Out, "%v: %v\n", m, ErrOutOfBounds)
+		case b.Cell(m) != Empty:
+			fmt.Fprintf(h.Out, "%v: %v\n", m, ErrCellTaken)
+		default:
+			return m, nil
+		}
+	}
+}
+
+func (p RandomPlayer) Name() string {
+	return "Acak"
+}
+
+func (p RandomPlayer) ChooseMove(b *Board, mark Mark) (Move, error) {
+	moves := b.LegalMoves()
+	if len(moves) == 0 {
+		return Move{}, ErrGameOver
+	}
+	return moves[p.Rand.Intn(len(moves))], nil
+}
+
+func (p *MinimaxPlayer) Name() string {
+	if p.MaxDepth == 0 {
+		return "Minimax"
+	}
+	return fmt.Sprintf("Minimax (kedalaman %d)", p.MaxDepth)
+}
+
+func (p *MinimaxPlayer) ChooseMove(b *Board, mark Mark) (Move, error) {
+	moves := orderedMoves(b)
+	if len(moves) == 0 {
+		return Move{}, ErrGameOver
+	}
+
+	// Search on a copy so the caller's board (and its history) is never
+	// touched, even if the search is cut short.
+	work := b.Clone()
+	p.Nodes = 0
+	win := b.winScore()
+	best, bestScore := moves[0], -win-1
+	alpha, beta := -win-1, win+1
+	for _, m := range moves {
+		work.set(m, mark)
+		score := -p.negamax(work, mark.Opponent(), m, 1, -beta, -alpha)
+		work.set(m, Empty)
+		if score > bestScore {
+			best, bestScore = m, score
+		}
+		alpha = max(alpha, score)
+	}
+	return best, nil
+}
+
+// negamax scores the position for mark, the side to move; last is the
+// opponent's move that led here. Wins found sooner score higher so the AI
+// takes the quickest win and delays a loss.
+func (p *MinimaxPlayer) negamax(b *Board, mark Mark, last Move, ply, alpha, beta int) int {
+	p.Nodes++
+	if b.completesLine(last) {
+		return -(b.winScore() - ply)
+	}
+	if b.empty == 0 {
+		return 0
+	}
+	if p.MaxDepth > 0 && ply >= p.MaxDepth {
+		return evaluateBoard(b, mark)
+	}
+
+	best := -b.winScore() - 1
+	for _, m := range orderedMoves(b) {
+		b.set(m, mark)
+		score := -p.negamax(b, mark.Opponent(), m, ply+1, -beta, -alpha)
+		b.set(m, Empty)
+		if score > best {
+			best = score
+		}
+		alpha = max(alpha, score)
+		if alpha >= beta {
+			break
+		}
+	}
+	return best
+}
+
+// orderedMoves lists the empty cells from the center outwards; trying
+// strong moves first makes alpha-beta cut off much earlier.
+func orderedMoves(b *Board) []Move {
+	moves := b.LegalMoves()
+	center := b.Size - 1 // doubled, to stay in integers
+	dist := func(m Move) int {
+		dr, dc := 2*m.Row-center, 2*m.Col-center
+		return max(dr, -dr, dc, -dc)
+	}
+	// Insertion sort: boards are small and the order must be stable so
+	// that the AI plays deterministically.
+	for i := 1; i < len(moves); i++ {
+		for j := i; j > 0 && dist(moves[j]) < dist(moves[j-1]); j-- {
+			moves[j], moves[j-1] = moves[j-1], moves[j]
+		}
+	}
+	return moves
+}
+
+// evaluateBoard scores an unfinished position for mark: every window of K
+// cells that only one side occupies is worth 10^(marks-1) to that side.
+// The sum saturates at ±heuristicLimit.
+func evaluateBoard(b *Board, mark Mark) int {
+	score := 0
+	for r := range b.Size {
+		for c := range b.Size {
+			for _, dir := range lineDirections {
+				end := Move{r + (b.K-1)*dir.Row, c + (b.K-1)*dir.Col}
+				if !b.inBounds(end) {
+					continue
+				}
+				mine, theirs := 0, 0
+				for i := range b.K {
+					switch b.cells[r+i*dir.Row][c+i*dir.Col] {
+					case mark:
+						mine++
+					case mark.Opponent():
+						theirs++
+					}
+				}
+				switch {
+				case theirs == 0 && mine > 0:
+					score = min(score+pow10(mine-1), heuristicLimit)
+				case mine == 0 && theirs > 0:
+					score = max(score-pow10(theirs-1), -heuristicLimit)
+				}
+			}
+		}
+	}
+	return score
+}
+
+// pow10 returns 10^n, saturating at heuristicLimit.
+func pow10(n int) int {
+	result := 1
+	for range n {
+		if result > heuristicLimit/10 {
+			return heuristicLimit
+		}
+		result *= 10
+	}
+	return result
+}
+
+// Run plays until the game ends and returns the winner (Empty for a draw).
+func (g *TicTacToeGame) Run() (Mark, error) {
+	fmt.Fprint(g.Out, g.Board)
+	for {
+		if winner, over := g.Board.Outcome(); over {
+			if winner == Empty {
+				fmt.Fprintln(g.Out, "Hasil: seri")
+			} else {
+				fmt.Fprintf(g.Out, "Hasil: %s (%s) menang\n", winner, g.Players[winner-MarkX].Name())
+			}
+			return winner, nil
+		}
+
+		mark := g.Board.Turn()
+		player := g.Players[mark-MarkX]
+		m, err := player.ChooseMove(g.Board, mark)
+		if err != nil {
+			return Empty, err
+		}
+		if err := g.Board.Play(m); err != nil {
+			return Empty, fmt.Errorf("%s memilih langkah tidak sah: %w", player.Name(), err)
+		}
+		fmt.Fprintf(g.Out, "\n%s (%s) memilih %v\n", mark, player.Name(), m)
+		fmt.Fprint(g.Out, g.Board)
+	}
+}
+
+func newTicTacToePlayer(kind string, depth int, in *bufio.Scanner, out io.Writer) (TicTacToePlayer, error) {
+	switch kind {
+	case "human":
+		return HumanPlayer{In: in, Out: out}, nil
+	case "ai":
+		return &MinimaxPlayer{MaxDepth: depth}, nil
+	case "random":
+		return RandomPlayer{Rand: rand.New(rand.NewSource(time.Now().UnixNano()))}, nil
+	}
+	return nil, fmt.Errorf("%w: jenis pemain tidak dikenal %q", errUsage, kind)
+}
+
+func ticTacToeCommand(args []string) error {
+	fs := flag.NewFlagSet("tictactoe", flag.ContinueOnError)
+	size := fs.Int("n", 3, fmt.Sprintf("ukuran papan N×N (paling besar %d)", maxTicTacToeSize))
+	k := fs.Int("k", 0, "jumlah berderet untuk menang (default: N)")
+	xKind := fs.String("x", "human", "pemain X: human, ai atau random")
+	oKind := fs.String("o", "ai", "pemain O: human, ai atau random")
+	depth := fs.Int("depth", -1, "kedalaman pencarian AI (0 = penuh; default penuh untuk 3×3, 4 selain itu)")
+	bench := fs.Bool("bench", false, "ukur kecepatan pencarian minimax lalu keluar")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: argumen berlebih %q", errUsage, fs.Args())
+	}
+	if *size > maxTicTacToeSize {
+		return fmt.Errorf("%w: -n paling besar %d", errUsage, maxTicTacToeSize)
+	}
+	if *k == 0 {
+		*k = *size
+	}
+	if *depth < 0 {
+		*depth = 0
+		if *size > 3 {
+			*depth = 4
+		}
+	}
+
+	board, err := NewBoard(*size, *k)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+
+	// One search from the empty board; `go test -bench Minimax` gives
+	// steadier numbers.
+	if *bench {
+		ai := &MinimaxPlayer{MaxDepth: *depth}
+		start := time.Now()
+		m, err := ai.ChooseMove(board, MarkX)
+		if err != nil {
+			return err
+		}
+		elapsed := time.Since(start)
+		fmt.Printf("Minimax %d×%d, %d berderet, kedalaman %d: langkah %v, %d posisi dalam %v (%.0f posisi/detik)\n",
+			*size, *size, *k, *depth, m, ai.Nodes, elapsed, float64(ai.Nodes)/elapsed.Seconds())
+		return nil
+	}
+
+	in := bufio.NewScanner(os.Stdin)
+	game := &TicTacToeGame{Board: board, Out: os.Stdout}
+	for i, kind := range []string{*xKind, *oKind} {
+		if game.Players[i], err = newTicTacToePlayer(kind, *depth, in, os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	if _, err := game.Run(); err != nil && !errors.Is(err, ErrQuit) {
+		return err
+	}
+	return nil
+}
+
+func ticTacToeExample() {
+	// The same position sliceExample builds by hand, X to move.
+	board, err := BoardFromRows([][]string{
+		{"X", "_", "_"},
+		{"_", "O", "_"},
+		{"_", "_", "_"},
+	}, 3)
+	if err != nil {
+		logln("Error:", err)
+		return
+	}
+	logf("%v", board)
+
+	ai := &MinimaxPlayer{}
+	m, _ := ai.ChooseMove(board, board.Turn())
+	logf("AI (%s) memilih %v setelah menilai %d posisi\n", board.Turn(), m, ai.Nodes)
+
+	logln("Langkah ke kotak terisi:", board.Play(Move{1, 1}))
+
+	logln("\nAI melawan AI di papan 3×3:")
+	game := &TicTacToeGame{Out: io.Discard}
+	game.Board, _ = NewBoard(3, 3)
+	game.Players = [2]TicTacToePlayer{&MinimaxPlayer{}, &MinimaxPlayer{}}
+	winner, _ := game.Run()
+	logf("%v", game.Board)
+	if winner == Empty {
+		logln("Hasil: seri, seperti seharusnya jika kedua pihak bermain sempurna")
+	} else {
+		logln("Pemenang:", winner)
+	}
+
+	logln("\nMinimax (kedalaman 4) melawan pemain acak di papan 5×5, 4 berderet:")
+	game = &TicTacToeGame{Out: io.Discard}
+	game.Board, _ = NewBoard(5, 4)
+	game.Players = [2]TicTacToePlayer{&MinimaxPlayer{MaxDepth: 4}, RandomPlayer{Rand: rand.New(rand.NewSource(1))}}
+	winner, _ = game.Run()
+	logf("%v", game.Board)
+	logf("Pemenang: %s setelah %d langkah\n", winner, len(game.Board.moves))
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func boardFromStrings(t testing.TB, k int, rows ...string) *Board {
+	t.Helper()
+	grid := make([][]string, len(rows))
+	for i, r := range rows {
+		grid[i] = strings.Split(r, "")
+	}
+	b, err := BoardFromRows(grid, k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestMinimaxTakesWin(t *testing.T) {
+	tests := []struct {
+		name  string
+		k     int
+		rows  []string
+		depth int
+		want  Move
+	}{
+		{"3×3 penuh", 3, []string{
+			"XX_",
+			"OO_",
+			"___",
+		}, 0, Move{0, 2}},
+		// Seven X in a row used to be worth as much as a win to the
+		// heuristic, so the AI blocked (8,8) instead of winning.
+		{"8×8, 8 berderet", 8, []string{
+			"XXXXXXX_",
+			"________",
+			"________",
+			"________",
+			"________",
+			"________",
+			"________",
+			"OOOOOOO_",
+		}, 1, Move{0, 7}},
+		{"8×8, 8 berderet, kedalaman 2", 8, []string{
+			"XXXXXXX_",
+			"________",
+			"________",
+			"________",
+			"________",
+			"________",
+			"________",
+			"OOOOOOO_",
+		}, 2, Move{0, 7}},
+	}
+	for _, tt := range tests {
+		b := boardFromStrings(t, tt.k, tt.rows...)
+		ai := &MinimaxPlayer{MaxDepth: tt.depth}
+		got, err := ai.ChooseMove(b, b.Turn())
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: memilih %v, mau %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluateBoardBelowWinScore(t *testing.T) {
+	for _, size := range []int{3, 8, 12, 20} {
+		b, err := NewBoard(size, size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Fill every row but the last column with X so that each row and
+		// most diagonals are one mark short of a win.
+		for r := range size {
+			for c := range size - 1 {
+				b.set(Move{r, c}, MarkX)
+			}
+		}
+		win := b.winScore() - size*size
+		if got := evaluateBoard(b, MarkX); got >= win || got < 0 {
+			t.Errorf("%d×%d: evaluateBoard = %d, mau di bawah %d", size, size, got, win)
+		}
+		if got := evaluateBoard(b, MarkO); got <= -win || got > 0 {
+			t.Errorf("%d×%d: evaluateBoard untuk O = %d, mau di atas %d", size, size, got, -win)
+		}
+	}
+}
+
+func TestPow10Saturates(t *testing.T) {
+	tests := []struct{ n, want int }{
+		{0, 1},
+		{3, 1000},
+		{8, 100_000_000},
+		{9, heuristicLimit},
+		{40, heuristicLimit},
+	}
+	for _, tt := range tests {
+		if got := pow10(tt.n); got != tt.want {
+			t.Errorf("pow10(%d) = %d, mau %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMinimaxSelfPlayDraws(t *testing.T) {
+	b, _ := NewBoard(3, 3)
+	game := &TicTacToeGame{Board: b, Players: [2]TicTacToePlayer{&MinimaxPlayer{}, &MinimaxPlayer{}}, Out: &strings.Builder{}}
+	winner, err := game.Run()
+	if err != nil || winner != Empty {
+		t.Errorf("minimax melawan minimax: pemenang %v, %v; mau seri", winner, err)
+	}
+}
+
+func TestBoardFromRowsCounts(t *testing.T) {
+	tests := []struct {
+		rows  []string
+		valid bool
+		turn  Mark
+	}{
+		{[]string{"___", "___", "___"}, true, MarkX},
+		{[]string{"X__", "___", "___"}, true, MarkO},
+		{[]string{"XO_", "___", "___"}, true, MarkX},
+		{[]string{"O__", "___", "___"}, false, Empty},
+		{[]string{"XX_", "___", "___"}, false, Empty},
+		{[]string{"XXO", "OO_", "___"}, false, Empty},
+		{[]string{"XXX", "O__", "___"}, false, Empty},
+	}
+	for _, tt := range tests {
+		grid := make([][]string, len(tt.rows))
+		for i, r := range tt.rows {
+			grid[i] = strings.Split(r, "")
+		}
+		b, err := BoardFromRows(grid, 3)
+		if !tt.valid {
+			if err == nil {
+				t.Errorf("BoardFromRows(%q) diterima, mau error", tt.rows)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("BoardFromRows(%q): %v", tt.rows, err)
+			continue
+		}
+		if got := b.Turn(); got != tt.turn {
+			t.Errorf("BoardFromRows(%q).Turn() = %v, mau %v", tt.rows, got, tt.turn)
+		}
+	}
+}
+
+// BenchmarkMinimax measures one search from the empty board.
+func BenchmarkMinimax(b *testing.B) {
+	for _, c := range []struct{ size, k, depth int }{
+		{3, 3, 0},
+		{4, 4, 4},
+		{5, 4, 4},
+		{8, 5, 3},
+	} {
+		b.Run(fmt.Sprintf("%dx%d_k%d_d%d", c.size, c.size, c.k, c.depth), func(b *testing.B) {
+			board, err := NewBoard(c.size, c.k)
+			if err != nil {
+				b.Fatal(err)
+			}
+			ai := &MinimaxPlayer{MaxDepth: c.depth}
+			nodes := 0
+			for b.Loop() {
+				if _, err := ai.ChooseMove(board, MarkX); err != nil {
+					b.Fatal(err)
+				}
+				nodes += ai.Nodes
+			}
+			b.ReportMetric(float64(nodes)/float64(b.N), "nodes/op")
+		})
+	}
+}
@@ -0,0 +1,563 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The inspector continues where `range "Go语言"` in basicTypesExample
+// stops: besides byte index and rune it shows what the bytes are, what
+// kind of character each rune is, how wide it is in a terminal and which
+// runes a reader sees as one character (a grapheme cluster).
+
+type RuneInfo struct {
+	Offset   int
+	Bytes    []byte
+	Rune     rune // utf8.RuneError when Valid is false
+	Valid    bool
+	Category string
+	Width    int
+	Cluster  int
+}
+
+type StringReport struct {
+	Input    string
+	Runes    []RuneInfo
+	Clusters []string
+	Invalid  []int // byte offsets of invalid UTF-8
+	Width    int
+	NFC, NFD string
+}
+
+type graphemeProp int
+
+const (
+	gpOther graphemeProp = iota
+	gpCR
+	gpLF
+	gpControl
+	gpExtend
+	gpZWJ
+	gpRegional
+	gpSpacingMark
+	gpL
+	gpV
+	gpT
+	gpLV
+	gpLVT
+	gpPictographic
+)
+
+const (
+	hangulBase   = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulTCount = 28
+	hangulCount  = 11172
+)
+
+var categoryNames = map[string]string{
+	"Lu": "huruf besar", "Ll": "huruf kecil", "Lt": "huruf judul", "Lm": "huruf pengubah", "Lo": "huruf lain",
+	"Mn": "tanda gabung", "Mc": "tanda spasi", "Me": "tanda pelingkup",
+	"Nd": "digit", "Nl": "angka huruf", "No": "angka lain",
+	"Pc": "tanda sambung", "Pd": "tanda hubung", "Ps": "kurung buka", "Pe": "kurung tutup",
+	"Pi": "kutip buka", "Pf": "kutip tutup", "Po": "tanda baca",
+	"Sm": "simbol matematika", "Sc": "simbol mata uang", "Sk": "simbol pengubah", "So": "simbol lain",
+	"Zs": "spasi", "Zl": "pemisah baris", "Zp": "pemisah paragraf",
+	"Cc": "kontrol", "Cf": "format", "Co": "pemakaian pribadi", "Cs": "surrogate",
+}
+
+// generalCategories holds the two-letter names from unicode.Categories in
+// a fixed order, so a rune always reports the same category. "LC" (any
+// cased letter) is a grouping, not a category of its own.
+var generalCategories = func() []string {
+	var names []string
+	for name := range unicode.Categories {
+		if len(name) == 2 && name != "LC" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// wideRanges approximates the East Asian Wide and Fullwidth characters
+// plus the emoji blocks that terminals draw two columns wide.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A}, {0x23E9, 0x23EC},
+	{0x25FD, 0x25FE}, {0x2614, 0x2615}, {0x2648, 0x2653}, {0x26AA, 0x26AB},
+	{0x26BD, 0x26BE}, {0x26C4, 0x26C5}, {0x26F2, 0x26F5}, {0x2705, 0x2705},
+	{0x270A, 0x270B}, {0x2728, 0x2728}, {0x274C, 0x274C}, {0x2753, 0x2757},
+	{0x2795, 0x2797}, {0x2B1B, 0x2B1C}, {0x2B50, 0x2B55}, {0x2E80, 0x303E},
+	{0x3041, 0x33FF}, {0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+	{0xA960, 0xA97F}, {0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F}, {0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x1F004, 0x1F004},
+	{0x1F0CF, 0x1F0CF}, {0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F2FF},
+	{0x1F300, 0x1F64F}, {0x1F680, 0x1F6FF}, {0x1F7E0, 0x1F7EB}, {0x1F900, 0x1F9FF},
+	{0x1FA70, 0x1FAFF}, {0x20000, 0x2FFFD}, {0x30000, 0x3FFFD},
+}
+
+// pictographicRanges approximates Extended_Pictographic, the property
+// that lets emoji joined with U+200D form one grapheme.
+var pictographicRanges = [][2]rune{
+	{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x203C, 0x203C}, {0x2049, 0x2049},
+	{0x2122, 0x2122}, {0x2139, 0x2139}, {0x2194, 0x21AA}, {0x231A, 0x23FF},
+	{0x24C2, 0x24C2}, {0x25AA, 0x25FE}, {0x2600, 0x27BF}, {0x2934, 0x2935},
+	{0x2B05, 0x2B55}, {0x3030, 0x3030}, {0x303D, 0x303D}, {0x3297, 0x3299},
+	{0x1F000, 0x1F1E5}, {0x1F200, 0x1F3FA}, {0x1F400, 0x1FAFF},
+}
+
+var errNotInspectable = errors.New("teks kosong")
+
+func init() {
+	registerCommand(command{
+		Name:  "unicode",
+		Usage: `unicode [-escape] TEKS...   (mis. unicode -escape "café \xff")`,
+		Run:   unicodeCommand,
+	})
+}
+
+func inRanges(r rune, ranges [][2]rune) bool {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i][1] >= r })
+	return i < len(ranges) && ranges[i][0] <= r
+}
+
+func unicodeCategory(r rune) string {
+	for _, name := range generalCategories {
+		if unicode.Is(unicode.Categories[name], r) {
+			return name
+		}
+	}
+	return "Cn" // unassigned
+}
+
+// runeWidth is the number of terminal columns r takes on its own.
+func runeWidth(r rune) int {
+	switch cat := unicodeCategory(r); {
+	case r == 0, cat == "Cc", cat == "Mn", cat == "Me", cat == "Cf":
+		return 0
+	case r >= 0x1160 && r <= 0x11FF: // Hangul medial vowels and final consonants
+		return 0
+	case inRanges(r, wideRanges):
+		return 2
+	}
+	return 1
+}
+
+func graphemeProperty(r rune) graphemeProp {
+	switch {
+	case r == '\r':
+		return gpCR
+	case r == '\n':
+		return gpLF
+	case r == 0x200D:
+		return gpZWJ
+	case r == 0x200C, r >= 0xFE00 && r <= 0xFE0F, r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F:
+		return gpExtend
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gpRegional
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gpL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gpV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gpT
+	case r >= hangulBase && r < hangulBase+hangulCount:
+		if (r-hangulBase)%hangulTCount == 0 {
+			return gpLV
+		}
+		return gpLVT
+	case inRanges(r, pictographicRanges):
+		return gpPictographic
+	}
+	switch unicodeCategory(r) {
+	case "Cc", "Cf", "Zl", "Zp", "Cs":
+		return gpControl
+	case "Mn", "Me":
+		return gpExtend
+	case "Mc":
+		return gpSpacingMark
+	}
+	return gpOther
+}
+
+// graphemeSegmenter applies the grapheme cluster rules of UAX #29 (minus
+// Prepend and the Indic conjunct rules) one rune at a time.
+type graphemeSegmenter struct {
+	prev      graphemeProp
+	started   bool
+	regional  int  // regional indicators in a row, for flag pairs
+	pictoSeen bool // inside Extended_Pictographic Extend* ZWJ?
+}
+
+// breakBefore reports whether a new cluster starts at a rune with
+// property p.
+func (g *graphemeSegmenter) breakBefore(p graphemeProp) bool {
+	prev := g.prev
+	first := !g.started
+	g.started = true
+	g.prev = p
+
+	emojiChain := g.pictoSeen && prev == gpZWJ
+	switch p {
+	case gpPictographic:
+		g.pictoSeen = true
+	case gpExtend, gpZWJ:
+	default:
+		g.pictoSeen = false
+	}
+	if p == gpRegional {
+		g.regional++
+	} else {
+		g.regional = 0
+	}
+
+	switch {
+	case first:
+		return true
+	case prev == gpCR && p == gpLF:
+		return false
+	case prev == gpCR, prev == gpLF, prev == gpControl, p == gpCR, p == gpLF, p == gpControl:
+		return true
+	case prev == gpL && (p == gpL || p == gpV || p == gpLV || p == gpLVT):
+		return false
+	case (prev == gpLV || prev == gpV) && (p == gpV || p == gpT):
+		return false
+	case (prev == gpLVT || prev == gpT) && p == gpT:
+		return false
+	case p == gpExtend, p == gpZWJ, p == gpSpacingMark:
+		return false
+	case emojiChain && p == gpPictographic:
+		return false
+	case prev == gpRegional && p == gpRegional:
+		// Flags are pairs: the second indicator joins the first, the
+		// third starts a new flag.
+		return g.regional%2 == 1
+	}
+	return true
+}
+
+// InspectString decodes s rune by rune. Invalid bytes are reported one at
+// a time, the way `range` over a string yields utf8.RuneError for them.
+func InspectString(s string) StringReport {
+	report := StringReport{Input: s, NFC: NFC(s), NFD: NFD(s)}
+	var seg graphemeSegmenter
+	clusterStart, cluster := 0, -1
+	for offset := 0; offset < len(s); {
+		r, size := utf8.DecodeRuneInString(s[offset:])
+		info := RuneInfo{Offset: offset, Bytes: []byte(s[offset : offset+size]), Rune: r, Valid: true}
+
+		prop := gpControl
+		if r == utf8.RuneError && size == 1 {
+			info.Valid = false
+			info.Category = "??"
+			info.Width = 1
+			report.Invalid = append(report.Invalid, offset)
+		} else {
+			info.Category = unicodeCategory(r)
+			info.Width = runeWidth(r)
+			prop = graphemeProperty(r)
+		}
+
+		if seg.breakBefore(prop) {
+			if cluster >= 0 {
+				report.Clusters = append(report.Clusters, s[clusterStart:offset])
+			}
+			cluster++
+			clusterStart = offset
+		}
+		info.Cluster = cluster
+		report.Runes = append(report.Runes, info)
+		offset += size
+	}
+	if cluster >= 0 {
+		report.Clusters = append(report.Clusters, s[clusterStart:])
+	}
+	for _, c := range report.Clusters {
+		report.Width += clusterWidth(c)
+	}
+	return report
+}
+
+// clusterWidth is how wide a terminal draws one grapheme: the width of
+// its base, or two for emoji presentation (VS16) and flag pairs.
+func clusterWidth(cluster string) int {
+	width, regional := 0, 0
+	for _, r := range cluster {
+		switch {
+		case r == 0xFE0F:
+			return 2
+		case r >= 0x1F1E6 && r <= 0x1F1FF:
+			regional++
+		}
+		if width == 0 {
+			width = runeWidth(r)
+		}
+	}
+	if regional == 2 {
+		return 2
+	}
+	return width
+}
+
+func combiningClassOf(r rune) uint8 {
+	if c, ok := combiningClass[r]; ok {
+		return c
+	}
+	if r >= 0x0300 && r <= 0x036F {
+		return 230
+	}
+	return 0
+}
+
+func decomposeRune(dst []rune, r rune) []rune {
+	if r >= hangulBase && r < hangulBase+hangulCount {
+		s := r - hangulBase
+		dst = append(dst, hangulLBase+s/(21*hangulTCount), hangulVBase+(s%(21*hangulTCount))/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	}
+	if d, ok := singletonDecomposition[r]; ok {
+		return decomposeRune(dst, d)
+	}
+	if d, ok := canonicalDecomposition[r]; ok {
+		return append(decomposeRune(dst, d[0]), d[1])
+	}
+	return append(dst, r)
+}
+
+// normalizationCovered reports whether unicodetables.go knows every
+// canonical decomposition of r's block.
+func normalizationCovered(r rune) bool {
+	_, singleton := singletonDecomposition[r]
+	return singleton || !inRanges(r, uncoveredRanges)
+}
+
+// mapValidUTF8 applies f to each run of valid UTF-8 in s and copies the
+// invalid bytes between them unchanged, so normalizing never turns a
+// stray byte into U+FFFD.
+func mapValidUTF8(s string, f func(string) string) string {
+	if utf8.ValidString(s) {
+		return f(s)
+	}
+	var b strings.Builder
+	for s != "" {
+		n := 0
+		for n < len(s) {
+			r, size := utf8.DecodeRuneInString(s[n:])
+			if r == utf8.RuneError && size == 1 {
+				break
+			}
+			n += size
+		}
+		b.WriteString(f(s[:n]))
+		if n < len(s) {
+			b.WriteByte(s[n])
+			n++
+		}
+		s = s[n:]
+	}
+	return b.String()
+}
+
+// NFD returns the canonical decomposition of s: precomposed characters
+// split into base plus marks, marks in canonical order. Only the scripts in
+// unicodetables.go are covered; invalid bytes are kept as they are.
+func NFD(s string) string {
+	return mapValidUTF8(s, nfd)
+}
+
+func nfd(s string) string {
+	var runes []rune
+	for _, r := range s {
+		runes = decomposeRune(runes, r)
+	}
+	// Canonical ordering: a stable sort of every run of non-starters by
+	// combining class.
+	for i := 0; i < len(runes); {
+		if combiningClassOf(runes[i]) == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && combiningClassOf(runes[j]) != 0 {
+			j++
+		}
+		run := runes[i:j]
+		sort.SliceStable(run, func(a, b int) bool { return combiningClassOf(run[a]) < combiningClassOf(run[b]) })
+		i = j
+	}
+	return string(runes)
+}
+
+var canonicalComposition = func() map[[2]rune]rune {
+	m := make(map[[2]rune]rune, len(canonicalDecomposition))
+	for composed, pair := range canonicalDecomposition {
+		m[pair] = composed
+	}
+	return m
+}()
+
+func composePair(a, b rune) (rune, bool) {
+	if a >= hangulLBase && a < hangulLBase+19 && b >= hangulVBase && b < hangulVBase+21 {
+		return hangulBase + ((a-hangulLBase)*21+(b-hangulVBase))*hangulTCount, true
+	}
+	if a >= hangulBase && a < hangulBase+hangulCount && (a-hangulBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + (b - hangulTBase), true
+	}
+	c, ok := canonicalComposition[[2]rune{a, b}]
+	return c, ok
+}
+
+// NFC decomposes s and then recombines every mark that has a precomposed
+// form with its base, following the canonical composition algorithm.
+// Invalid bytes are kept as they are.
+func NFC(s string) string {
+	return mapValidUTF8(s, nfc)
+}
+
+func nfc(s string) string {
+	runes := []rune(nfd(s))
+	if len(runes) == 0 {
+		return ""
+	}
+	out := runes[:1]
+	starter := 0
+	lastClass := combiningClassOf(runes[0])
+	if lastClass != 0 {
+		starter = -1
+	}
+	for _, r := range runes[1:] {
+		class := combiningClassOf(r)
+		// A mark can join the starter unless an earlier mark with the same
+		// or a higher class sits between them ("blocked").
+		blocked := len(out)-1 != starter && (lastClass == 0 || lastClass >= class)
+		if starter >= 0 && !blocked {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(out)
+		}
+		lastClass = class
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+func describeRune(info RuneInfo) string {
+	switch {
+	case !info.Valid:
+		return "�"
+	case info.Category == "Mn" || info.Category == "Me":
+		return "◌" + string(info.Rune)
+	case info.Category == "Cc" || info.Category == "Cf" || info.Category == "Zl" || info.Category == "Zp":
+		return strings.Trim(strconv.QuoteRune(info.Rune), "'")
+	}
+	return string(info.Rune)
+}
+
+func hexBytes(b []byte) string {
+	parts := make([]string, len(b))
+	for i, c := range b {
+		parts[i] = fmt.Sprintf("%02X", c)
+	}
+	return strings.Join(parts, " ")
+}
+
+// padDisplay pads s with spaces to width terminal columns.
+func padDisplay(s string, width int) string {
+	w := 0
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return s + strings.Repeat(" ", max(width-w, 0))
+}
+
+func (r StringReport) Write(w io.Writer) {
+	fmt.Fprintf(w, "Teks %q: %d byte, %d rune, %d grafem, lebar %d kolom\n",
+		r.Input, len(r.Input), len(r.Runes), len(r.Clusters), r.Width)
+	fmt.Fprintf(w, "  %6s  %-12s  %-8s  %-5s  %-22s  %5s  %s\n", "offset", "byte", "kode", "rune", "kategori", "lebar", "grafem")
+	for i, info := range r.Runes {
+		code := fmt.Sprintf("%U", info.Rune)
+		category := info.Category + " " + categoryNames[info.Category]
+		if !info.Valid {
+			code, category = "-", "UTF-8 tidak valid"
+		}
+		cluster := "└"
+		if i == 0 || r.Runes[i-1].Cluster != info.Cluster {
+			cluster = strconv.Itoa(info.Cluster + 1)
+		}
+		fmt.Fprintf(w, "  %6d  %-12s  %-8s  %s  %-22s  %5d  %s\n",
+			info.Offset, hexBytes(info.Bytes), code, padDisplay(describeRune(info), 5), category, info.Width, cluster)
+	}
+
+	for _, offset := range r.Invalid {
+		fmt.Fprintf(w, "  UTF-8 tidak valid pada offset %d (byte %02X)\n", offset, r.Input[offset])
+	}
+	for _, form := range []struct{ name, value string }{{"NFC", r.NFC}, {"NFD", r.NFD}} {
+		if form.value == r.Input {
+			fmt.Fprintf(w, "  %s: sama dengan input\n", form.name)
+			continue
+		}
+		fmt.Fprintf(w, "  %s: berbeda, %q (%d byte, %d rune)\n",
+			form.name, form.value, len(form.value), utf8.RuneCountInString(form.value))
+	}
+	for _, info := range r.Runes {
+		if info.Valid && !normalizationCovered(info.Rune) {
+			fmt.Fprintf(w, "  NFC/NFD hanya sebagian: tabel belum mencakup %U dan aksara sejenisnya, yang dibiarkan apa adanya\n", info.Rune)
+			break
+		}
+	}
+}
+
+func unicodeCommand(args []string) error {
+	fs := flag.NewFlagSet("unicode", flag.ContinueOnError)
+	escape := fs.Bool("escape", false, `tafsirkan escape Go seperti \xff, é dan \U0001F600`)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	text := strings.Join(fs.Args(), " ")
+	if text == "" {
+		return fmt.Errorf("%w: %v", errUsage, errNotInspectable)
+	}
+	if *escape {
+		unquoted, err := strconv.Unquote(`"` + strings.ReplaceAll(text, `"`, `\"`) + `"`)
+		if err != nil {
+			return fmt.Errorf("escape tidak valid dalam %q: %w", text, err)
+		}
+		text = unquoted
+	}
+	InspectString(text).Write(os.Stdout)
+	return nil
+}
+
+func unicodeExample() {
+	logOutput(func(w io.Writer) { InspectString("Go语言").Write(w) })
+
+	composed, decomposed := "café", "café"
+	logln()
+	logOutput(func(w io.Writer) { InspectString(decomposed).Write(w) })
+	logf("%q == %q? %t, setelah NFC? %t\n", composed, decomposed, composed == decomposed, NFC(composed) == NFC(decomposed))
+
+	logln()
+	for _, s := range []string{"Go€", "Tiếng Việt", "한국어", "\U0001F44D\U0001F3FD\U0001F1EE\U0001F1E9", "\U0001F468\u200D\U0001F469\u200D\U0001F467", "ab\xffc"} {
+		r := InspectString(s)
+		logf("%-14q %2d byte, %2d rune, %d grafem, lebar %d, UTF-8 tidak valid: %d\n",
+			s, len(s), len(r.Runes), len(r.Clusters), r.Width, len(r.Invalid))
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestInspectStringClusters(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		clusters []string
+		width    int
+	}{
+		{"ASCII", "Go!", []string{"G", "o", "!"}, 3},
+		{"CJK", "Go语言", []string{"G", "o", "语", "言"}, 6},
+		{"keluarga ZWJ", "\U0001F468\u200D\U0001F469\u200D\U0001F467", []string{"\U0001F468\u200D\U0001F469\u200D\U0001F467"}, 2},
+		{"ZWJ tanpa emoji", "a\u200Db", []string{"a\u200D", "b"}, 2},
+		{"warna kulit", "\U0001F44D\U0001F3FD!", []string{"\U0001F44D\U0001F3FD", "!"}, 3},
+		{"dua bendera", "\U0001F1EE\U0001F1E9\U0001F1EF\U0001F1F5", []string{"\U0001F1EE\U0001F1E9", "\U0001F1EF\U0001F1F5"}, 4},
+		{"indikator ganjil", "\U0001F1EE\U0001F1E9\U0001F1EF", []string{"\U0001F1EE\U0001F1E9", "\U0001F1EF"}, 3},
+		{"CRLF", "a\r\nb\n\r", []string{"a", "\r\n", "b", "\n", "\r"}, 2},
+		{"Hangul L V T", "\u1100\u1161\u11A8\u1100", []string{"\u1100\u1161\u11A8", "\u1100"}, 4},
+		{"Hangul LV T", "\uAC00\u11A8\uAC01\u11A8", []string{"\uAC00\u11A8", "\uAC01\u11A8"}, 4},
+		{"tanda gabung", "e\u0323\u0302x", []string{"e\u0323\u0302", "x"}, 2},
+		{"tanda gabung terbalik", "e\u0302\u0323", []string{"e\u0302\u0323"}, 1},
+		{"UTF-8 tidak valid", "ab\xffc\xe2\x82", []string{"a", "b", "\xff", "c", "\xe2", "\x82"}, 6},
+	}
+	for _, tt := range tests {
+		r := InspectString(tt.in)
+		if !slices.Equal(r.Clusters, tt.clusters) {
+			t.Errorf("%s: grafem %q, mau %q", tt.name, r.Clusters, tt.clusters)
+		}
+		if r.Width != tt.width {
+			t.Errorf("%s: lebar %d, mau %d", tt.name, r.Width, tt.width)
+		}
+	}
+}
+
+func TestInspectStringInvalid(t *testing.T) {
+	r := InspectString("ab\xffc\xe2\x82")
+	if !slices.Equal(r.Invalid, []int{2, 4, 5}) {
+		t.Errorf("offset tidak valid %v, mau [2 4 5]", r.Invalid)
+	}
+	if r.Runes[2].Valid || r.Runes[2].Offset != 2 || r.Runes[3].Rune != 'c' {
+		t.Errorf("rune %+v dan %+v, mau byte tidak valid lalu 'c'", r.Runes[2], r.Runes[3])
+	}
+	var out strings.Builder
+	r.Write(&out)
+	for _, want := range []string{"UTF-8 tidak valid pada offset 2 (byte FF)", "NFC: sama dengan input", "NFD: sama dengan input"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("keluaran tidak berisi %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestNormalization(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		nfc, nfd string
+	}{
+		{"ASCII", "cafe", "cafe", "cafe"},
+		{"é", "caf\u00E9", "caf\u00E9", "cafe\u0301"},
+		{"e + ◌́", "cafe\u0301", "caf\u00E9", "cafe\u0301"},
+		{"ệ", "\u1EC7", "\u1EC7", "e\u0323\u0302"},
+		{"e + ◌̣ + ◌̂", "e\u0323\u0302", "\u1EC7", "e\u0323\u0302"},
+		{"e + ◌̂ + ◌̣", "e\u0302\u0323", "\u1EC7", "e\u0323\u0302"},
+		{"ế", "e\u0302\u0301", "\u1EBF", "e\u0302\u0301"},
+		{"dua tanda atas", "a\u0301\u0302", "\u00E1\u0302", "a\u0301\u0302"},
+		{"ANGSTROM SIGN", "\u212B", "\u00C5", "A\u030A"},
+		{"KELVIN SIGN", "\u212A", "K", "K"},
+		{"OHM SIGN", "\u2126", "\u03A9", "\u03A9"},
+		{"Hangul", "\uAC01", "\uAC01", "\u1100\u1161\u11A8"},
+		{"jamo L V T", "\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8"},
+		{"tanda tanpa dasar", "\u0302e", "\u0302e", "\u0302e"},
+		{"byte tidak valid", "caf\xffe\u0301\xe2", "caf\xff\u00E9\xe2", "caf\xffe\u0301\xe2"},
+		{"tanda setelah byte tidak valid", "\xff\u0301", "\xff\u0301", "\xff\u0301"},
+	}
+	for _, tt := range tests {
+		if got := NFC(tt.in); got != tt.nfc {
+			t.Errorf("%s: NFC(%+q) = %+q, mau %+q", tt.name, tt.in, got, tt.nfc)
+		}
+		if got := NFD(tt.in); got != tt.nfd {
+			t.Errorf("%s: NFD(%+q) = %+q, mau %+q", tt.name, tt.in, got, tt.nfd)
+		}
+	}
+}
+
+func TestNormalizationCoverageNote(t *testing.T) {
+	const note = "NFC/NFD hanya sebagian"
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"Tiếng Việt", false},
+		{"한국어", false},
+		{"Go语言", false},
+		{"\u212B", false},
+		{"\u0386\u03B8\u03AE\u03BD\u03B1", true}, // Ά di tabel Unicode, tapi tidak di sini
+		{"\u304C", true},                         // が = か + ゛
+	}
+	for _, tt := range tests {
+		var out strings.Builder
+		InspectString(tt.in).Write(&out)
+		if got := strings.Contains(out.String(), note); got != tt.want {
+			t.Errorf("%q: catatan cakupan %t, mau %t:\n%s", tt.in, got, tt.want, out.String())
+		}
+	}
+}
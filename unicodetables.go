@@ -0,0 +1,181 @@
+package main
+
+// Normalization data for the Unicode inspector. The standard library has
+// no NFC/NFD, so this file carries just enough of the Unicode Character
+// Database for the scripts our i18n work meets most: the canonical
+// decompositions of Latin-1, Latin Extended-A/B and Latin Extended
+// Additional (which covers Vietnamese), and the combining classes of the
+// combining diacritical marks block, plus the singleton decompositions
+// outside the CJK compatibility block (Å ANGSTROM SIGN, Ω OHM SIGN, …).
+// Hangul syllables are decomposed algorithmically and need no table.
+// Characters outside these ranges, Greek and Cyrillic among them, pass
+// through NFC/NFD unchanged; the inspector says so when it meets one.
+//
+// The values come from Unicode 14 and never change between versions:
+// Unicode guarantees that existing decompositions are stable.
+
+// canonicalDecomposition maps a precomposed character to its base and
+// combining mark. The base may itself decompose further (ệ → ẹ + ◌̂).
+var canonicalDecomposition = map[rune][2]rune{
+	0x00C0: {'A', 0x0300}, 0x00C1: {'A', 0x0301}, 0x00C2: {'A', 0x0302}, 0x00C3: {'A', 0x0303},
+	0x00C4: {'A', 0x0308}, 0x00C5: {'A', 0x030A}, 0x00C7: {'C', 0x0327}, 0x00C8: {'E', 0x0300},
+	0x00C9: {'E', 0x0301}, 0x00CA: {'E', 0x0302}, 0x00CB: {'E', 0x0308}, 0x00CC: {'I', 0x0300},
+	0x00CD: {'I', 0x0301}, 0x00CE: {'I', 0x0302}, 0x00CF: {'I', 0x0308}, 0x00D1: {'N', 0x0303},
+	0x00D2: {'O', 0x0300}, 0x00D3: {'O', 0x0301}, 0x00D4: {'O', 0x0302}, 0x00D5: {'O', 0x0303},
+	0x00D6: {'O', 0x0308}, 0x00D9: {'U', 0x0300}, 0x00DA: {'U', 0x0301}, 0x00DB: {'U', 0x0302},
+	0x00DC: {'U', 0x0308}, 0x00DD: {'Y', 0x0301}, 0x00E0: {'a', 0x0300}, 0x00E1: {'a', 0x0301},
+	0x00E2: {'a', 0x0302}, 0x00E3: {'a', 0x0303}, 0x00E4: {'a', 0x0308}, 0x00E5: {'a', 0x030A},
+	0x00E7: {'c', 0x0327}, 0x00E8: {'e', 0x0300}, 0x00E9: {'e', 0x0301}, 0x00EA: {'e', 0x0302},
+	0x00EB: {'e', 0x0308}, 0x00EC: {'i', 0x0300}, 0x00ED: {'i', 0x0301}, 0x00EE: {'i', 0x0302},
+	0x00EF: {'i', 0x0308}, 0x00F1: {'n', 0x0303}, 0x00F2: {'o', 0x0300}, 0x00F3: {'o', 0x0301},
+	0x00F4: {'o', 0x0302}, 0x00F5: {'o', 0x0303}, 0x00F6: {'o', 0x0308}, 0x00F9: {'u', 0x0300},
+	0x00FA: {'u', 0x0301}, 0x00FB: {'u', 0x0302}, 0x00FC: {'u', 0x0308}, 0x00FD: {'y', 0x0301},
+	0x00FF: {'y', 0x0308}, 0x0100: {'A', 0x0304}, 0x0101: {'a', 0x0304}, 0x0102: {'A', 0x0306},
+	0x0103: {'a', 0x0306}, 0x0104: {'A', 0x0328}, 0x0105: {'a', 0x0328}, 0x0106: {'C', 0x0301},
+	0x0107: {'c', 0x0301}, 0x0108: {'C', 0x0302}, 0x0109: {'c', 0x0302}, 0x010A: {'C', 0x0307},
+	0x010B: {'c', 0x0307}, 0x010C: {'C', 0x030C}, 0x010D: {'c', 0x030C}, 0x010E: {'D', 0x030C},
+	0x010F: {'d', 0x030C}, 0x0112: {'E', 0x0304}, 0x0113: {'e', 0x0304}, 0x0114: {'E', 0x0306},
+	0x0115: {'e', 0x0306}, 0x0116: {'E', 0x0307}, 0x0117: {'e', 0x0307}, 0x0118: {'E', 0x0328},
+	0x0119: {'e', 0x0328}, 0x011A: {'E', 0x030C}, 0x011B: {'e', 0x030C}, 0x011C: {'G', 0x0302},
+	0x011D: {'g', 0x0302}, 0x011E: {'G', 0x0306}, 0x011F: {'g', 0x0306}, 0x0120: {'G', 0x0307},
+	0x0121: {'g', 0x0307}, 0x0122: {'G', 0x0327}, 0x0123: {'g', 0x0327}, 0x0124: {'H', 0x0302},
+	0x0125: {'h', 0x0302}, 0x0128: {'I', 0x0303}, 0x0129: {'i', 0x0303}, 0x012A: {'I', 0x0304},
+	0x012B: {'i', 0x0304}, 0x012C: {'I', 0x0306}, 0x012D: {'i', 0x0306}, 0x012E: {'I', 0x0328},
+	0x012F: {'i', 0x0328}, 0x0130: {'I', 0x0307}, 0x0134: {'J', 0x0302}, 0x0135: {'j', 0x0302},
+	0x0136: {'K', 0x0327}, 0x0137: {'k', 0x0327}, 0x0139: {'L', 0x0301}, 0x013A: {'l', 0x0301},
+	0x013B: {'L', 0x0327}, 0x013C: {'l', 0x0327}, 0x013D: {'L', 0x030C}, 0x013E: {'l', 0x030C},
+	0x0143: {'N', 0x0301}, 0x0144: {'n', 0x0301}, 0x0145: {'N', 0x0327}, 0x0146: {'n', 0x0327},
+	0x0147: {'N', 0x030C}, 0x0148: {'n', 0x030C}, 0x014C: {'O', 0x0304}, 0x014D: {'o', 0x0304},
+	0x014E: {'O', 0x0306}, 0x014F: {'o', 0x0306}, 0x0150: {'O', 0x030B}, 0x0151: {'o', 0x030B},
+	0x0154: {'R', 0x0301}, 0x0155: {'r', 0x0301}, 0x0156: {'R', 0x0327}, 0x0157: {'r', 0x0327},
+	0x0158: {'R', 0x030C}, 0x0159: {'r', 0x030C}, 0x015A: {'S', 0x0301}, 0x015B: {'s', 0x0301},
+	0x015C: {'S', 0x0302}, 0x015D: {'s', 0x0302}, 0x015E: {'S', 0x0327}, 0x015F: {'s', 0x0327},
+	0x0160: {'S', 0x030C}, 0x0161: {'s', 0x030C}, 0x0162: {'T', 0x0327}, 0x0163: {'t', 0x0327},
+	0x0164: {'T', 0x030C}, 0x0165: {'t', 0x030C}, 0x0168: {'U', 0x0303}, 0x0169: {'u', 0x0303},
+	0x016A: {'U', 0x0304}, 0x016B: {'u', 0x0304}, 0x016C: {'U', 0x0306}, 0x016D: {'u', 0x0306},
+	0x016E: {'U', 0x030A}, 0x016F: {'u', 0x030A}, 0x0170: {'U', 0x030B}, 0x0171: {'u', 0x030B},
+	0x0172: {'U', 0x0328}, 0x0173: {'u', 0x0328}, 0x0174: {'W', 0x0302}, 0x0175: {'w', 0x0302},
+	0x0176: {'Y', 0x0302}, 0x0177: {'y', 0x0302}, 0x0178: {'Y', 0x0308}, 0x0179: {'Z', 0x0301},
+	0x017A: {'z', 0x0301}, 0x017B: {'Z', 0x0307}, 0x017C: {'z', 0x0307}, 0x017D: {'Z', 0x030C},
+	0x017E: {'z', 0x030C}, 0x01A0: {'O', 0x031B}, 0x01A1: {'o', 0x031B}, 0x01AF: {'U', 0x031B},
+	0x01B0: {'u', 0x031B}, 0x01CD: {'A', 0x030C}, 0x01CE: {'a', 0x030C}, 0x01CF: {'I', 0x030C},
+	0x01D0: {'i', 0x030C}, 0x01D1: {'O', 0x030C}, 0x01D2: {'o', 0x030C}, 0x01D3: {'U', 0x030C},
+	0x01D4: {'u', 0x030C}, 0x01D5: {0x00DC, 0x0304}, 0x01D6: {0x00FC, 0x0304}, 0x01D7: {0x00DC, 0x0301},
+	0x01D8: {0x00FC, 0x0301}, 0x01D9: {0x00DC, 0x030C}, 0x01DA: {0x00FC, 0x030C}, 0x01DB: {0x00DC, 0x0300},
+	0x01DC: {0x00FC, 0x0300}, 0x01DE: {0x00C4, 0x0304}, 0x01DF: {0x00E4, 0x0304}, 0x01E0: {0x0226, 0x0304},
+	0x01E1: {0x0227, 0x0304}, 0x01E2: {0x00C6, 0x0304}, 0x01E3: {0x00E6, 0x0304}, 0x01E6: {'G', 0x030C},
+	0x01E7: {'g', 0x030C}, 0x01E8: {'K', 0x030C}, 0x01E9: {'k', 0x030C}, 0x01EA: {'O', 0x0328},
+	0x01EB: {'o', 0x0328}, 0x01EC: {0x01EA, 0x0304}, 0x01ED: {0x01EB, 0x0304}, 0x01EE: {0x01B7, 0x030C},
+	0x01EF: {0x0292, 0x030C}, 0x01F0: {'j', 0x030C}, 0x01F4: {'G', 0x0301}, 0x01F5: {'g', 0x0301},
+	0x01F8: {'N', 0x0300}, 0x01F9: {'n', 0x0300}, 0x01FA: {0x00C5, 0x0301}, 0x01FB: {0x00E5, 0x0301},
+	0x01FC: {0x00C6, 0x0301}, 0x01FD: {0x00E6, 0x0301}, 0x01FE: {0x00D8, 0x0301}, 0x01FF: {0x00F8, 0x0301},
+	0x0200: {'A', 0x030F}, 0x0201: {'a', 0x030F}, 0x0202: {'A', 0x0311}, 0x0203: {'a', 0x0311},
+	0x0204: {'E', 0x030F}, 0x0205: {'e', 0x030F}, 0x0206: {'E', 0x0311}, 0x0207: {'e', 0x0311},
+	0x0208: {'I', 0x030F}, 0x0209: {'i', 0x030F}, 0x020A: {'I', 0x0311}, 0x020B: {'i', 0x0311},
+	0x020C: {'O', 0x030F}, 0x020D: {'o', 0x030F}, 0x020E: {'O', 0x0311}, 0x020F: {'o', 0x0311},
+	0x0210: {'R', 0x030F}, 0x0211: {'r', 0x030F}, 0x0212: {'R', 0x0311}, 0x0213: {'r', 0x0311},
+	0x0214: {'U', 0x030F}, 0x0215: {'u', 0x030F}, 0x0216: {'U', 0x0311}, 0x0217: {'u', 0x0311},
+	0x0218: {'S', 0x0326}, 0x0219: {'s', 0x0326}, 0x021A: {'T', 0x0326}, 0x021B: {'t', 0x0326},
+	0x021E: {'H', 0x030C}, 0x021F: {'h', 0x030C}, 0x0226: {'A', 0x0307}, 0x0227: {'a', 0x0307},
+	0x0228: {'E', 0x0327}, 0x0229: {'e', 0x0327}, 0x022A: {0x00D6, 0x0304}, 0x022B: {0x00F6, 0x0304},
+	0x022C: {0x00D5, 0x0304}, 0x022D: {0x00F5, 0x0304}, 0x022E: {'O', 0x0307}, 0x022F: {'o', 0x0307},
+	0x0230: {0x022E, 0x0304}, 0x0231: {0x022F, 0x0304}, 0x0232: {'Y', 0x0304}, 0x0233: {'y', 0x0304},
+	0x1E00: {'A', 0x0325}, 0x1E01: {'a', 0x0325}, 0x1E02: {'B', 0x0307}, 0x1E03: {'b', 0x0307},
+	0x1E04: {'B', 0x0323}, 0x1E05: {'b', 0x0323}, 0x1E06: {'B', 0x0331}, 0x1E07: {'b', 0x0331},
+	0x1E08: {0x00C7, 0x0301}, 0x1E09: {0x00E7, 0x0301}, 0x1E0A: {'D', 0x0307}, 0x1E0B: {'d', 0x0307},
+	0x1E0C: {'D', 0x0323}, 0x1E0D: {'d', 0x0323}, 0x1E0E: {'D', 0x0331}, 0x1E0F: {'d', 0x0331},
+	0x1E10: {'D', 0x0327}, 0x1E11: {'d', 0x0327}, 0x1E12: {'D', 0x032D}, 0x1E13: {'d', 0x032D},
+	0x1E14: {0x0112, 0x0300}, 0x1E15: {0x0113, 0x0300}, 0x1E16: {0x0112, 0x0301}, 0x1E17: {0x0113, 0x0301},
+	0x1E18: {'E', 0x032D}, 0x1E19: {'e', 0x032D}, 0x1E1A: {'E', 0x0330}, 0x1E1B: {'e', 0x0330},
+	0x1E1C: {0x0228, 0x0306}, 0x1E1D: {0x0229, 0x0306}, 0x1E1E: {'F', 0x0307}, 0x1E1F: {'f', 0x0307},
+	0x1E20: {'G', 0x0304}, 0x1E21: {'g', 0x0304}, 0x1E22: {'H', 0x0307}, 0x1E23: {'h', 0x0307},
+	0x1E24: {'H', 0x0323}, 0x1E25: {'h', 0x0323}, 0x1E26: {'H', 0x0308}, 0x1E27: {'h', 0x0308},
+	0x1E28: {'H', 0x0327}, 0x1E29: {'h', 0x0327}, 0x1E2A: {'H', 0x032E}, 0x1E2B: {'h', 0x032E},
+	0x1E2C: {'I', 0x0330}, 0x1E2D: {'i', 0x0330}, 0x1E2E: {0x00CF, 0x0301}, 0x1E2F: {0x00EF, 0x0301},
+	0x1E30: {'K', 0x0301}, 0x1E31: {'k', 0x0301}, 0x1E32: {'K', 0x0323}, 0x1E33: {'k', 0x0323},
+	0x1E34: {'K', 0x0331}, 0x1E35: {'k', 0x0331}, 0x1E36: {'L', 0x0323}, 0x1E37: {'l', 0x0323},
+	0x1E38: {0x1E36, 0x0304}, 0x1E39: {0x1E37, 0x0304}, 0x1E3A: {'L', 0x0331}, 0x1E3B: {'l', 0x0331},
+	0x1E3C: {'L', 0x032D}, 0x1E3D: {'l', 0x032D}, 0x1E3E: {'M', 0x0301}, 0x1E3F: {'m', 0x0301},
+	0x1E40: {'M', 0x0307}, 0x1E41: {'m', 0x0307}, 0x1E42: {'M', 0x0323}, 0x1E43: {'m', 0x0323},
+	0x1E44: {'N', 0x0307}, 0x1E45: {'n', 0x0307}, 0x1E46: {'N', 0x0323}, 0x1E47: {'n', 0x0323},
+	0x1E48: {'N', 0x0331}, 0x1E49: {'n', 0x0331}, 0x1E4A: {'N', 0x032D}, 0x1E4B: {'n', 0x032D},
+	0x1E4C: {0x00D5, 0x0301}, 0x1E4D: {0x00F5, 0x0301}, 0x1E4E: {0x00D5, 0x0308}, 0x1E4F: {0x00F5, 0x0308},
+	0x1E50: {0x014C, 0x0300}, 0x1E51: {0x014D, 0x0300}, 0x1E52: {0x014C, 0x0301}, 0x1E53: {0x014D, 0x0301},
+	0x1E54: {'P', 0x0301}, 0x1E55: {'p', 0x0301}, 0x1E56: {'P', 0x0307}, 0x1E57: {'p', 0x0307},
+	0x1E58: {'R', 0x0307}, 0x1E59: {'r', 0x0307}, 0x1E5A: {'R', 0x0323}, 0x1E5B: {'r', 0x0323},
+	0x1E5C: {0x1E5A, 0x0304}, 0x1E5D: {0x1E5B, 0x0304}, 0x1E5E: {'R', 0x0331}, 0x1E5F: {'r', 0x0331},
+	0x1E60: {'S', 0x0307}, 0x1E61: {'s', 0x0307}, 0x1E62: {'S', 0x0323}, 0x1E63: {'s', 0x0323},
+	0x1E64: {0x015A, 0x0307}, 0x1E65: {0x015B, 0x0307}, 0x1E66: {0x0160, 0x0307}, 0x1E67: {0x0161, 0x0307},
+	0x1E68: {0x1E62, 0x0307}, 0x1E69: {0x1E63, 0x0307}, 0x1E6A: {'T', 0x0307}, 0x1E6B: {'t', 0x0307},
+	0x1E6C: {'T', 0x0323}, 0x1E6D: {'t', 0x0323}, 0x1E6E: {'T', 0x0331}, 0x1E6F: {'t', 0x0331},
+	0x1E70: {'T', 0x032D}, 0x1E71: {'t', 0x032D}, 0x1E72: {'U', 0x0324}, 0x1E73: {'u', 0x0324},
+	0x1E74: {'U', 0x0330}, 0x1E75: {'u', 0x0330}, 0x1E76: {'U', 0x032D}, 0x1E77: {'u', 0x032D},
+	0x1E78: {0x0168, 0x0301}, 0x1E79: {0x0169, 0x0301}, 0x1E7A: {0x016A, 0x0308}, 0x1E7B: {0x016B, 0x0308},
+	0x1E7C: {'V', 0x0303}, 0x1E7D: {'v', 0x0303}, 0x1E7E: {'V', 0x0323}, 0x1E7F: {'v', 0x0323},
+	0x1E80: {'W', 0x0300}, 0x1E81: {'w', 0x0300}, 0x1E82: {'W', 0x0301}, 0x1E83: {'w', 0x0301},
+	0x1E84: {'W', 0x0308}, 0x1E85: {'w', 0x0308}, 0x1E86: {'W', 0x0307}, 0x1E87: {'w', 0x0307},
+	0x1E88: {'W', 0x0323}, 0x1E89: {'w', 0x0323}, 0x1E8A: {'X', 0x0307}, 0x1E8B: {'x', 0x0307},
+	0x1E8C: {'X', 0x0308}, 0x1E8D: {'x', 0x0308}, 0x1E8E: {'Y', 0x0307}, 0x1E8F: {'y', 0x0307},
+	0x1E90: {'Z', 0x0302}, 0x1E91: {'z', 0x0302}, 0x1E92: {'Z', 0x0323}, 0x1E93: {'z', 0x0323},
+	0x1E94: {'Z', 0x0331}, 0x1E95: {'z', 0x0331}, 0x1E96: {'h', 0x0331}, 0x1E97: {'t', 0x0308},
+	0x1E98: {'w', 0x030A}, 0x1E99: {'y', 0x030A}, 0x1E9B: {0x017F, 0x0307}, 0x1EA0: {'A', 0x0323},
+	0x1EA1: {'a', 0x0323}, 0x1EA2: {'A', 0x0309}, 0x1EA3: {'a', 0x0309}, 0x1EA4: {0x00C2, 0x0301},
+	0x1EA5: {0x00E2, 0x0301}, 0x1EA6: {0x00C2, 0x0300}, 0x1EA7: {0x00E2, 0x0300}, 0x1EA8: {0x00C2, 0x0309},
+	0x1EA9: {0x00E2, 0x0309}, 0x1EAA: {0x00C2, 0x0303}, 0x1EAB: {0x00E2, 0x0303}, 0x1EAC: {0x1EA0, 0x0302},
+	0x1EAD: {0x1EA1, 0x0302}, 0x1EAE: {0x0102, 0x0301}, 0x1EAF: {0x0103, 0x0301}, 0x1EB0: {0x0102, 0x0300},
+	0x1EB1: {0x0103, 0x0300}, 0x1EB2: {0x0102, 0x0309}, 0x1EB3: {0x0103, 0x0309}, 0x1EB4: {0x0102, 0x0303},
+	0x1EB5: {0x0103, 0x0303}, 0x1EB6: {0x1EA0, 0x0306}, 0x1EB7: {0x1EA1, 0x0306}, 0x1EB8: {'E', 0x0323},
+	0x1EB9: {'e', 0x0323}, 0x1EBA: {'E', 0x0309}, 0x1EBB: {'e', 0x0309}, 0x1EBC: {'E', 0x0303},
+	0x1EBD: {'e', 0x0303}, 0x1EBE: {0x00CA, 0x0301}, 0x1EBF: {0x00EA, 0x0301}, 0x1EC0: {0x00CA, 0x0300},
+	0x1EC1: {0x00EA, 0x0300}, 0x1EC2: {0x00CA, 0x0309}, 0x1EC3: {0x00EA, 0x0309}, 0x1EC4: {0x00CA, 0x0303},
+	0x1EC5: {0x00EA, 0x0303}, 0x1EC6: {0x1EB8, 0x0302}, 0x1EC7: {0x1EB9, 0x0302}, 0x1EC8: {'I', 0x0309},
+	0x1EC9: {'i', 0x0309}, 0x1ECA: {'I', 0x0323}, 0x1ECB: {'i', 0x0323}, 0x1ECC: {'O', 0x0323},
+	0x1ECD: {'o', 0x0323}, 0x1ECE: {'O', 0x0309}, 0x1ECF: {'o', 0x0309}, 0x1ED0: {0x00D4, 0x0301},
+	0x1ED1: {0x00F4, 0x0301}, 0x1ED2: {0x00D4, 0x0300}, 0x1ED3: {0x00F4, 0x0300}, 0x1ED4: {0x00D4, 0x0309},
+	0x1ED5: {0x00F4, 0x0309}, 0x1ED6: {0x00D4, 0x0303}, 0x1ED7: {0x00F4, 0x0303}, 0x1ED8: {0x1ECC, 0x0302},
+	0x1ED9: {0x1ECD, 0x0302}, 0x1EDA: {0x01A0, 0x0301}, 0x1EDB: {0x01A1, 0x0301}, 0x1EDC: {0x01A0, 0x0300},
+	0x1EDD: {0x01A1, 0x0300}, 0x1EDE: {0x01A0, 0x0309}, 0x1EDF: {0x01A1, 0x0309}, 0x1EE0: {0x01A0, 0x0303},
+	0x1EE1: {0x01A1, 0x0303}, 0x1EE2: {0x01A0, 0x0323}, 0x1EE3: {0x01A1, 0x0323}, 0x1EE4: {'U', 0x0323},
+	0x1EE5: {'u', 0x0323}, 0x1EE6: {'U', 0x0309}, 0x1EE7: {'u', 0x0309}, 0x1EE8: {0x01AF, 0x0301},
+	0x1EE9: {0x01B0, 0x0301}, 0x1EEA: {0x01AF, 0x0300}, 0x1EEB: {0x01B0, 0x0300}, 0x1EEC: {0x01AF, 0x0309},
+	0x1EED: {0x01B0, 0x0309}, 0x1EEE: {0x01AF, 0x0303}, 0x1EEF: {0x01B0, 0x0303}, 0x1EF0: {0x01AF, 0x0323},
+	0x1EF1: {0x01B0, 0x0323}, 0x1EF2: {'Y', 0x0300}, 0x1EF3: {'y', 0x0300}, 0x1EF4: {'Y', 0x0323},
+	0x1EF5: {'y', 0x0323}, 0x1EF6: {'Y', 0x0309}, 0x1EF7: {'y', 0x0309}, 0x1EF8: {'Y', 0x0303},
+	0x1EF9: {'y', 0x0303},
+}
+
+// singletonDecomposition maps a character to the one character that
+// canonically replaces it. Singletons never come back under NFC: U+212B
+// becomes U+00C5, not U+212B again.
+var singletonDecomposition = map[rune]rune{
+	0x0340: 0x0300, 0x0341: 0x0301, 0x0343: 0x0313, 0x0374: 0x02B9,
+	0x037E: 0x003B, 0x0387: 0x00B7, 0x1FEF: 0x0060, 0x1FFD: 0x00B4,
+	0x2000: 0x2002, 0x2001: 0x2003, 0x2126: 0x03A9, 0x212A: 0x004B,
+	0x212B: 0x00C5, 0x2329: 0x3008, 0x232A: 0x3009,
+}
+
+// uncoveredRanges are the blocks with canonical decompositions that the
+// tables above leave out: Greek, Cyrillic, the Indic and Middle Eastern
+// scripts (Balinese included), kana with dakuten and the CJK compatibility
+// ideographs.
+var uncoveredRanges = [][2]rune{
+	{0x0370, 0x04FF}, {0x0590, 0x0DFF}, {0x0F00, 0x0FFF}, {0x1B00, 0x1B7F},
+	{0x1F00, 0x1FFF}, {0x3040, 0x30FF}, {0xF900, 0xFAFF}, {0xFB1D, 0xFB4F},
+	{0x1D15E, 0x1D1C0}, {0x2F800, 0x2FA1F},
+}
+
+// combiningClass lists the marks in U+0300–U+036F whose canonical combining
+// class is not 230 (above the base); the rest of the block is 230.
+var combiningClass = map[rune]uint8{
+	0x0315: 232, 0x0316: 220, 0x0317: 220, 0x0318: 220, 0x0319: 220, 0x031A: 232,
+	0x031B: 216, 0x031C: 220, 0x031D: 220, 0x031E: 220, 0x031F: 220, 0x0320: 220,
+	0x0321: 202, 0x0322: 202, 0x0323: 220, 0x0324: 220, 0x0325: 220, 0x0326: 220,
+	0x0327: 202, 0x0328: 202, 0x0329: 220, 0x032A: 220, 0x032B: 220, 0x032C: 220,
+	0x032D: 220, 0x032E: 220, 0x032F: 220, 0x0330: 220, 0x0331: 220, 0x0332: 220,
+	0x0333: 220, 0x0334: 1, 0x0335: 1, 0x0336: 1, 0x0337: 1, 0x0338: 1,
+	0x0339: 220, 0x033A: 220, 0x033B: 220, 0x033C: 220, 0x0345: 240, 0x0347: 220,
+	0x0348: 220, 0x0349: 220, 0x034D: 220, 0x034E: 220, 0x034F: 0, 0x0353: 220,
+	0x0354: 220, 0x0355: 220, 0x0356: 220, 0x0358: 232, 0x0359: 220, 0x035A: 220,
+	0x035C: 233, 0x035D: 234, 0x035E: 234, 0x035F: 233, 0x0360: 234, 0x0361: 234,
+	0x0362: 233,
+}
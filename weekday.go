@@ -0,0 +1,289 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Weekday gives the Sunday…Saturday constants a type. The numbering is the
+// same as time.Weekday, so conversions in both directions are free.
+type Weekday int
+
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// Calendar decides which days are work days: everything except the
+// weekend days and the listed holidays.
+type Calendar struct {
+	Weekend  map[Weekday]bool
+	holidays map[string]string
+}
+
+var (
+	weekdayNamesID  = [7]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+	weekdayNamesEN  = [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	weekdayAbbrevID = [7]string{"Min", "Sen", "Sel", "Rab", "Kam", "Jum", "Sab"}
+	weekdayAbbrevEN = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+)
+
+var weekdayLookup = func() map[string]Weekday {
+	m := map[string]Weekday{"ahad": Sunday, "jum'at": Friday}
+	for d := range 7 {
+		for _, names := range [][7]string{weekdayNamesID, weekdayNamesEN, weekdayAbbrevID, weekdayAbbrevEN} {
+			m[strings.ToLower(names[d])] = Weekday(d)
+		}
+	}
+	return m
+}()
+
+func (d Weekday) Valid() bool {
+	return d >= Sunday && d <= Saturday
+}
+
+func (d Weekday) String() string {
+	if !d.Valid() {
+		return fmt.Sprintf("Weekday(%d)", int(d))
+	}
+	return weekdayNamesID[d]
+}
+
+func (d Weekday) English() string {
+	if !d.Valid() {
+		return fmt.Sprintf("Weekday(%d)", int(d))
+	}
+	return weekdayNamesEN[d]
+}
+
+func (d Weekday) Abbrev(english bool) string {
+	if !d.Valid() {
+		return fmt.Sprintf("Weekday(%d)", int(d))
+	}
+	if english {
+		return weekdayAbbrevEN[d]
+	}
+	return weekdayAbbrevID[d]
+}
+
+// ParseWeekday accepts Indonesian and English names and their three-letter
+// abbreviations in any letter case.
+func ParseWeekday(s string) (Weekday, error) {
+	d, ok := weekdayLookup[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return 0, fmt.Errorf("nama hari tidak dikenal: %q", s)
+	}
+	return d, nil
+}
+
+func (d Weekday) MarshalText() ([]byte, error) {
+	if !d.Valid() {
+		return nil, fmt.Errorf("hari tidak valid: %d", int(d))
+	}
+	return []byte(d.String()), nil
+}
+
+func (d *Weekday) UnmarshalText(text []byte) error {
+	v, err := ParseWeekday(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func WeekdayOf(t time.Time) Weekday {
+	return Weekday(t.Weekday())
+}
+
+func (d Weekday) Time() time.Weekday {
+	return time.Weekday(d)
+}
+
+// Add moves n days forward (or backward for negative n), wrapping around
+// the week.
+func (d Weekday) Add(n int) Weekday {
+	return Weekday(((int(d)+n)%7 + 7) % 7)
+}
+
+func (d Weekday) Next() Weekday {
+	return d.Add(1)
+}
+
+func (d Weekday) Prev() Weekday {
+	return d.Add(-1)
+}
+
+func (d Weekday) IsWeekend() bool {
+	return d == Saturday || d == Sunday
+}
+
+// WeekFrom lists all seven days starting at first, e.g. WeekFrom(Monday)
+// for the Indonesian (and ISO) week.
+func WeekFrom(first Weekday) []Weekday {
+	days := make([]Weekday, 7)
+	for i := range days {
+		days[i] = first.Add(i)
+	}
+	return days
+}
+
+func dateKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func NewCalendar(holidays ...Holiday) *Calendar {
+	c := &Calendar{
+		Weekend:  map[Weekday]bool{Saturday: true, Sunday: true},
+		holidays: map[string]string{},
+	}
+	for _, h := range holidays {
+		c.AddHoliday(h)
+	}
+	return c
+}
+
+// IndonesianFixedHolidays lists the national holidays that fall on the
+// same date every year. Holidays that follow the lunar calendars (Idul
+// Fitri, Nyepi, Waisak, …) change yearly and must be added separately.
+func IndonesianFixedHolidays(year int) []Holiday {
+	date := func(m time.Month, d int) time.Time {
+		return time.Date(year, m, d, 0, 0, 0, 0, time.Local)
+	}
+	return []Holiday{
+		{date(time.January, 1), "Tahun Baru Masehi"},
+		{date(time.May, 1), "Hari Buruh Internasional"},
+		{date(time.June, 1), "Hari Lahir Pancasila"},
+		{date(time.August, 17), "Hari Kemerdekaan RI"},
+		{date(time.December, 25), "Hari Raya Natal"},
+	}
+}
+
+func (c *Calendar) AddHoliday(h Holiday) {
+	c.holidays[dateKey(h.Date)] = h.Name
+}
+
+func (c *Calendar) Holidays() []Holiday {
+	keys := make([]string, 0, len(c.holidays))
+	for k := range c.holidays {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	holidays := make([]Holiday, len(keys))
+	for i, k := range keys {
+		t, _ := time.ParseInLocation(time.DateOnly, k, time.Local)
+		holidays[i] = Holiday{Date: t, Name: c.holidays[k]}
+	}
+	return holidays
+}
+
+func (c *Calendar) HolidayName(t time.Time) (string, bool) {
+	name, ok := c.holidays[dateKey(t)]
+	return name, ok
+}
+
+func (c *Calendar) IsWorkDay(t time.Time) bool {
+	if c.Weekend[WeekdayOf(t)] {
+		return false
+	}
+	_, holiday := c.HolidayName(t)
+	return !holiday
+}
+
+// NextOccurrence returns the first date strictly after from that falls on
+// day d.
+func NextOccurrence(from time.Time, d Weekday) time.Time {
+	from = truncateToDate(from)
+	diff := (int(d) - int(WeekdayOf(from)) + 7) % 7
+	if diff == 0 {
+		diff = 7
+	}
+	return from.AddDate(0, 0, diff)
+}
+
+// AddBusinessDays moves n work days away from t (backwards for negative
+// n). Days that are not work days never count, including t itself.
+func (c *Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	t = truncateToDate(t)
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsWorkDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// BusinessDaysBetween counts the work days in the half-open range
+// (from, to]. When to lies before from it counts [to, from) and returns
+// the count negated, so that it undoes AddBusinessDays in both directions.
+func (c *Calendar) BusinessDaysBetween(from, to time.Time) int {
+	from, to = truncateToDate(from), truncateToDate(to)
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to.AddDate(0, 0, -1), from.AddDate(0, 0, -1), -1
+	}
+	count := 0
+	for t := from.AddDate(0, 0, 1); !t.After(to); t = t.AddDate(0, 0, 1) {
+		if c.IsWorkDay(t) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+func (c *Calendar) WorkDaysInMonth(year int, month time.Month) []time.Time {
+	var days []time.Time
+	for t := time.Date(year, month, 1, 0, 0, 0, 0, time.Local); t.Month() == month; t = t.AddDate(0, 0, 1) {
+		if c.IsWorkDay(t) {
+			days = append(days, t)
+		}
+	}
+	return days
+}
+
+func calendarExample() {
+	logln("Hari dalam seminggu (mulai Senin):")
+	for _, d := range WeekFrom(Monday) {
+		logf("  %d %-6s %-9s %s/%s akhir pekan=%t\n", int(d), d, d.English(), d.Abbrev(false), d.Abbrev(true), d.IsWeekend())
+	}
+
+	for _, s := range []string{"jumat", "WED", "Ahad", "Funday"} {
+		d, err := ParseWeekday(s)
+		if err != nil {
+			logln("  Parse:", err)
+			continue
+		}
+		logf("  Parse %q -> %s\n", s, d)
+	}
+
+	cal := NewCalendar(IndonesianFixedHolidays(2026)...)
+	start := time.Date(2026, time.August, 13, 0, 0, 0, 0, time.Local)
+
+	logln("\nJadwal aktivitas mulai", start.Format("2006-01-02"))
+	for i := range 7 {
+		t := start.AddDate(0, 0, i)
+		activity := dailyActivity(WeekdayOf(t))
+		if name, ok := cal.HolidayName(t); ok {
+			activity = "Libur nasional: " + name
+		}
+		logf("  %s %-6s %s\n", t.Format("02 Jan"), WeekdayOf(t), activity)
+	}
+
+	logln("\nSenin berikutnya:", NextOccurrence(start, Monday).Format("2006-01-02"))
+	due := cal.AddBusinessDays(start, 5)
+	logln("5 hari kerja setelahnya:", due.Format("2006-01-02"), WeekdayOf(due))
+	logln("Hari kerja di Agustus 2026:", len(cal.WorkDaysInMonth(2026, time.August)))
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	t, err := time.ParseInLocation(time.DateOnly, s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func testCalendar() *Calendar {
+	return NewCalendar(append(IndonesianFixedHolidays(2026), IndonesianFixedHolidays(2027)...)...)
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Weekday
+	}{
+		{"Senin", Monday},
+		{"jumat", Friday},
+		{" jum'at ", Friday},
+		{"Ahad", Sunday},
+		{"minggu", Sunday},
+		{"WED", Wednesday},
+		{"saturday", Saturday},
+		{"Sab", Saturday},
+	}
+	for _, tt := range tests {
+		got, err := ParseWeekday(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseWeekday(%q) = %v, %v, mau %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"", "Funday", "Mingguan", "Se"} {
+		if got, err := ParseWeekday(in); err == nil {
+			t.Errorf("ParseWeekday(%q) = %v, mau error", in, got)
+		}
+	}
+	for d := Sunday; d <= Saturday; d++ {
+		for _, name := range []string{d.String(), d.English(), d.Abbrev(false), d.Abbrev(true)} {
+			if got, err := ParseWeekday(name); err != nil || got != d {
+				t.Errorf("ParseWeekday(%q) = %v, %v, mau %v", name, got, err, d)
+			}
+		}
+	}
+}
+
+func TestWeekdayInvalidFallback(t *testing.T) {
+	for _, d := range []Weekday{-1, 7} {
+		want := d.String()
+		if got := d.English(); got != want {
+			t.Errorf("English() = %q, mau %q", got, want)
+		}
+		if got := d.Abbrev(false); got != want {
+			t.Errorf("Abbrev(false) = %q, mau %q", got, want)
+		}
+		if got := d.Abbrev(true); got != want {
+			t.Errorf("Abbrev(true) = %q, mau %q", got, want)
+		}
+		if _, err := d.MarshalText(); err == nil {
+			t.Errorf("MarshalText(%d) tidak menghasilkan error", int(d))
+		}
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	thursday := day("2026-08-13").Add(23*time.Hour + 59*time.Minute)
+	tests := []struct {
+		d    Weekday
+		want string
+	}{
+		{Friday, "2026-08-14"},
+		{Monday, "2026-08-17"},
+		{Wednesday, "2026-08-19"},
+		{Thursday, "2026-08-20"}, // hari yang sama tidak dihitung
+	}
+	for _, tt := range tests {
+		if got := NextOccurrence(thursday, tt.d); !got.Equal(day(tt.want)) {
+			t.Errorf("NextOccurrence(Kamis, %v) = %v, mau %s", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	cal := testCalendar()
+	tests := []struct {
+		from string
+		n    int
+		want string
+	}{
+		{"2026-08-13", 0, "2026-08-13"},
+		{"2026-08-13", 1, "2026-08-14"},
+		{"2026-08-13", 2, "2026-08-18"}, // akhir pekan dan 17 Agustus dilewati
+		{"2026-08-13", 5, "2026-08-21"},
+		{"2026-08-15", 1, "2026-08-18"}, // mulai dari Sabtu
+		{"2026-08-18", -1, "2026-08-14"},
+		{"2026-08-16", -1, "2026-08-14"},
+		{"2026-12-24", 1, "2026-12-28"},
+		{"2026-12-24", 6, "2027-01-05"}, // Natal dan Tahun Baru jatuh hari Jumat
+	}
+	for _, tt := range tests {
+		if got := cal.AddBusinessDays(day(tt.from), tt.n); !got.Equal(day(tt.want)) {
+			t.Errorf("AddBusinessDays(%s, %d) = %s, mau %s", tt.from, tt.n, got.Format(time.DateOnly), tt.want)
+		}
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	cal := testCalendar()
+	tests := []struct {
+		from, to string
+		want     int
+	}{
+		{"2026-08-13", "2026-08-13", 0},
+		{"2026-08-13", "2026-08-21", 5},
+		{"2026-08-21", "2026-08-13", -5},
+		{"2026-08-14", "2026-08-17", 0},  // Sabtu, Minggu, libur
+		{"2026-08-17", "2026-08-14", -1}, // mundur: [14, 17) berisi Jumat
+		{"2026-12-24", "2027-01-05", 6},
+	}
+	for _, tt := range tests {
+		if got := cal.BusinessDaysBetween(day(tt.from), day(tt.to)); got != tt.want {
+			t.Errorf("BusinessDaysBetween(%s, %s) = %d, mau %d", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	// AddBusinessDays dan BusinessDaysBetween saling membatalkan, juga
+	// dari hari libur.
+	for _, from := range []string{"2026-08-13", "2026-08-15", "2026-08-17", "2026-12-25"} {
+		for n := -15; n <= 15; n++ {
+			to := cal.AddBusinessDays(day(from), n)
+			if got := cal.BusinessDaysBetween(day(from), to); got != n {
+				t.Errorf("BusinessDaysBetween(%s, AddBusinessDays(%d)) = %d", from, n, got)
+			}
+		}
+	}
+}